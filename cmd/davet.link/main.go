@@ -10,9 +10,13 @@ import (
 	"davet.link/configs/fileconfig"
 	"davet.link/configs/logconfig"
 	"davet.link/configs/sessionconfig"
+	"davet.link/middlewares"
 	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/slugs"
 	"davet.link/pkg/templatehelpers"
+	"davet.link/pkg/themes"
 	"davet.link/routes"
+	"davet.link/services"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/template/html/v2"
@@ -38,14 +42,23 @@ func main() {
 	fileconfig.InitFileConfig()
 
 	fileconfig.Config.SetAllowedExtensions("cards", []string{"jpg", "png", "webp"})
-	fileconfig.Config.SetAllowedExtensions("invitations", []string{"jpeg", "png"})
+	fileconfig.Config.SetAllowedExtensions("invitations", []string{"jpg", "jpeg", "png"})
+	fileconfig.Config.SetAllowedExtensions("og", []string{"png"})
 
 	engine := html.New("./views", ".html")
 	engine.AddFunc("getFlashMessages", flashmessages.GetFlashMessages)
 	engine.AddFuncMap(templatehelpers.TemplateHelpers())
 
+	if err := themes.Validate("./views", "./public"); err != nil {
+		logconfig.Log.Fatal("Davetiye temaları doğrulanamadı", zap.Error(err))
+	}
+
 	app := fiber.New(fiber.Config{
 		Views: engine,
+		// Gövde akış olarak alınır ve sınırı middlewares.BodyLimitMiddleware uygular; böylece yalnızca galeri
+		// yüklemesi 4 MB'yi aşabilir ve yüklenen fotoğraflar belleğe alınmadan geçici dosyalara yazılır
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
 			code := fiber.StatusInternalServerError
 			message := "Internal Server Error"
@@ -68,6 +81,11 @@ func main() {
 	})
 
 	app.Static("/", "./public")
+	app.Static("/uploads/invitations", fileconfig.Config.GetPath(services.InvitationMediaContentType))
+	if err := slugs.ReserveDir("./public"); err != nil {
+		logconfig.Log.Warn("public klasörü okunamadı, dosya adları adres olarak ayrılamadı", zap.Error(err))
+	}
+	app.Use(middlewares.BodyLimitMiddleware(routes.IsUploadRequest))
 	app.Use(csrfconfig.SetupCSRF())
 	routes.SetupRoutes(app)
 
@@ -108,5 +126,8 @@ func startServer(app *fiber.App) {
 		logconfig.Log.Info("Sunucu başarıyla kapatıldı")
 	}
 
+	// Arabellekteki görüntülenmeler veritabanı kapanmadan yazılır
+	services.NewAnalyticsService().Flush()
+
 	logconfig.Log.Info("Uygulama başarıyla sonlandırıldı.")
 }
@@ -17,6 +17,30 @@ var csrfExemptPaths = []string{
 	// "rotalar",
 }
 
+// Oturum gerektiren alanlar
+var csrfAuthenticatedPrefixes = []string{
+	"/auth",
+	"/panel",
+	"/dashboard",
+}
+
+// Oturumlu alanlarda girişe, herkese açık formlarda formun bulunduğu sayfaya dönülür. Herkese açık formlar
+// ilk yol parçasındaki sayfada gösterilir (ör. /123asd1/guestbook formu /123asd1 sayfasındadır)
+func csrfFailureRedirect(path string) string {
+	for _, prefix := range csrfAuthenticatedPrefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return "/auth/login"
+		}
+	}
+	if path == "" || !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return "/"
+	}
+	if i := strings.Index(path[1:], "/"); i >= 0 {
+		return path[:i+1]
+	}
+	return path
+}
+
 func SetupCSRF() fiber.Handler {
 	config := csrf.Config{
 		KeyLookup:      "header:X-CSRF-Token",
@@ -35,7 +59,7 @@ func SetupCSRF() fiber.Handler {
 				zap.String("method", c.Method()),
 			)
 			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Güvenlik doğrulaması başarısız oldu. Lütfen sayfayı yenileyip tekrar deneyin.")
-			return c.Redirect("/auth/login", fiber.StatusSeeOther)
+			return c.Redirect(csrfFailureRedirect(c.Path()), fiber.StatusSeeOther)
 		},
 		Next: func(c *fiber.Ctx) bool {
 			token := c.Get("X-CSRF-Token")
@@ -0,0 +1,27 @@
+package csrfconfig
+
+import "testing"
+
+func TestCSRFFailureRedirect(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/panel/invitations/rsvp/3", "/auth/login"},
+		{"/dashboard", "/auth/login"},
+		{"/auth/login", "/auth/login"},
+		{"/123asd1", "/123asd1"},
+		{"/123asd1/guestbook", "/123asd1"},
+		{"/123asd1/guestbook/", "/123asd1"},
+		{"/panelist", "/panelist"},
+		{"/", "/"},
+		{"", "/"},
+		{"//evil.example", "/"},
+		{"evil", "/"},
+	}
+	for _, tt := range tests {
+		if got := csrfFailureRedirect(tt.path); got != tt.want {
+			t.Errorf("csrfFailureRedirect(%q) = %q, %q bekleniyordu", tt.path, got, tt.want)
+		}
+	}
+}
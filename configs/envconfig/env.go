@@ -3,6 +3,8 @@ package envconfig
 import (
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 func GetEnvWithDefault(key, defaultValue string) string {
@@ -29,3 +31,23 @@ func GetEnvAsInt(key string, defaultValue int) int {
 func IsProduction() bool {
 	return os.Getenv("APP_ENV") == "production"
 }
+
+// GetBaseURL returns APP_BASE_URL without a trailing slash.
+func GetBaseURL() string {
+	return strings.TrimRight(GetEnvWithDefault("APP_BASE_URL", "https://davet.link"), "/")
+}
+
+// GetTimeZone returns the APP_TIMEZONE name, defaulting to Europe/Istanbul.
+func GetTimeZone() string {
+	return GetEnvWithDefault("APP_TIMEZONE", "Europe/Istanbul")
+}
+
+// GetLocation returns the APP_TIMEZONE location, defaulting to Europe/Istanbul.
+func GetLocation() *time.Location {
+	loc, err := time.LoadLocation(GetTimeZone())
+	if err != nil {
+		// tzdata bulunamazsa Türkiye saatine (UTC+3) düşülür
+		return time.FixedZone("+03", 3*60*60)
+	}
+	return loc
+}
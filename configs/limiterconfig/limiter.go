@@ -1,6 +1,11 @@
 package limiterconfig
 
 import (
+	"time"
+
+	"davet.link/configs/envconfig"
+
+	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/limiter"
 )
 
@@ -10,3 +15,16 @@ func GetLimiterConfig() limiter.Config {
 		Expiration: 60,
 	}
 }
+
+// GetGuestbookLimiterConfig, davetiye defterine aynı IP'den aynı davetiyeye gönderilen mesajları sınırlar.
+// Sınır GUESTBOOK_RATE_LIMIT ile 10 dakikalık pencere başına ayarlanabilir.
+func GetGuestbookLimiterConfig(limitReached fiber.Handler) limiter.Config {
+	return limiter.Config{
+		Max:        envconfig.GetEnvAsInt("GUESTBOOK_RATE_LIMIT", 5),
+		Expiration: 10 * time.Minute,
+		KeyGenerator: func(c *fiber.Ctx) string {
+			return "guestbook:" + c.IP() + ":" + c.Params("invitationKey")
+		},
+		LimitReached: limitReached,
+	}
+}
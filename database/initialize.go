@@ -91,6 +91,36 @@ func RunMigrationsInOrder(db *gorm.DB) error {
 	if err := migrations.MigrateCardSocialMediaTable(db); err != nil {
 		return err
 	}
+	if err := migrations.MigratePageViewsTable(db); err != nil {
+		return err
+	}
+	if err := migrations.MigratePagesTable(db); err != nil {
+		return err
+	}
+	if err := migrations.MigrateInvitationTranslationsTable(db); err != nil {
+		return err
+	}
+	if err := migrations.MigrateGuestbookEntriesTable(db); err != nil {
+		return err
+	}
+	if err := migrations.MigrateInvitationMediaTable(db); err != nil {
+		return err
+	}
+	if err := migrations.MigrateInvitationEventsTable(db); err != nil {
+		return err
+	}
+	if err := migrations.MigrateGuestsTable(db); err != nil {
+		return err
+	}
+	if err := migrations.MigrateRSVPQuestionsTable(db); err != nil {
+		return err
+	}
+	if err := migrations.MigrateParticipantCompanionsTable(db); err != nil {
+		return err
+	}
+	if err := migrations.MigrateSeatingTablesTable(db); err != nil {
+		return err
+	}
 	return nil
 }
 
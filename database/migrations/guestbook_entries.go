@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"gorm.io/gorm"
+)
+
+func MigrateGuestbookEntriesTable(db *gorm.DB) error {
+	logconfig.SLog.Info("GuestbookEntry tablosu migrate ediliyor...")
+	if err := db.AutoMigrate(&models.GuestbookEntry{}); err != nil {
+		return err
+	}
+	logconfig.SLog.Info("GuestbookEntry tablosu migrate işlemi tamamlandı.")
+	return nil
+}
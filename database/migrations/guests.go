@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"gorm.io/gorm"
+)
+
+func MigrateGuestsTable(db *gorm.DB) error {
+	logconfig.SLog.Info("Guest tablosu migrate ediliyor...")
+	if err := db.AutoMigrate(&models.Guest{}); err != nil {
+		return err
+	}
+	logconfig.SLog.Info("Guest tablosu migrate işlemi tamamlandı.")
+	return nil
+}
@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"gorm.io/gorm"
+)
+
+func MigrateInvitationEventsTable(db *gorm.DB) error {
+	logconfig.SLog.Info("InvitationEvent tablosu migrate ediliyor...")
+	if err := db.AutoMigrate(&models.InvitationEvent{}); err != nil {
+		return err
+	}
+	logconfig.SLog.Info("InvitationEvent tablosu migrate işlemi tamamlandı.")
+	return nil
+}
@@ -11,6 +11,32 @@ func MigrateInvitationParticipantsTable(db *gorm.DB) error {
 	if err := db.AutoMigrate(&models.InvitationParticipant{}); err != nil {
 		return err
 	}
+	if err := migrateParticipantResponses(db); err != nil {
+		return err
+	}
 	logconfig.SLog.Info("InvitationParticipant tablosu migrate işlemi tamamlandı.")
 	return nil
 }
+
+// migrateParticipantResponses, yanıt geçmişi tablosunu oluşturur. Tablo ilk kez oluşturuluyorsa
+// mevcut bildirimlerin yanıt zamanı kayıt zamanından doldurulur ve her biri için ilk geçmiş kaydı eklenir.
+func migrateParticipantResponses(db *gorm.DB) error {
+	created := !db.Migrator().HasTable(&models.ParticipantResponse{})
+	if err := db.AutoMigrate(&models.ParticipantResponse{}); err != nil {
+		return err
+	}
+	if !created {
+		return nil
+	}
+	logconfig.SLog.Info("Mevcut katılım bildirimleri yanıt geçmişine aktarılıyor...")
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`UPDATE invitation_participants SET responded_at = created_at
+			WHERE responded_at IS NULL OR responded_at < '0002-01-01'`).Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec(`INSERT INTO participant_responses (invitation_id, participant_id, status, guest_count, created_at)
+			SELECT invitation_id, id, status, guest_count, responded_at FROM invitation_participants
+			WHERE deleted_at IS NULL`).Error
+	})
+}
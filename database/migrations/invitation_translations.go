@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"gorm.io/gorm"
+)
+
+func MigrateInvitationTranslationsTable(db *gorm.DB) error {
+	logconfig.SLog.Info("InvitationTranslation tablosu migrate ediliyor...")
+	if err := db.AutoMigrate(&models.InvitationTranslation{}); err != nil {
+		return err
+	}
+	logconfig.SLog.Info("InvitationTranslation tablosu migrate işlemi tamamlandı.")
+	return nil
+}
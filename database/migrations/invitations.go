@@ -1,6 +1,7 @@
 package migrations
 
 import (
+	"davet.link/configs/logconfig"
 	"davet.link/models"
 	"gorm.io/gorm"
 )
@@ -8,3 +9,29 @@ import (
 func CreateInvitationsTable(db *gorm.DB) error {
 	return db.AutoMigrate(&models.Invitation{})
 }
+
+// migrateInvitationSchedule, eski date/time sütunlarını starts_at ve all_day alanlarına taşıyıp siler.
+// Eski time sütunu tarihle birleştirilmiş saati tuttuğundan doğrudan başlangıç olarak kullanılır.
+func migrateInvitationSchedule(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasColumn("invitations", "date") {
+		return nil
+	}
+	logconfig.SLog.Info("Davetiye tarih ve saatleri starts_at alanına taşınıyor...")
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`UPDATE invitations SET
+			starts_at = CASE WHEN "time" IS NULL OR "time" < '0002-01-01' THEN "date" ELSE "time" END,
+			all_day = ("time" IS NULL OR "time" < '0002-01-01')
+			WHERE "date" IS NOT NULL AND "date" >= '0002-01-01'`).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Migrator().DropColumn("invitations", "date"); err != nil {
+			return err
+		}
+		if tx.Migrator().HasColumn("invitations", "time") {
+			return tx.Migrator().DropColumn("invitations", "time")
+		}
+		return nil
+	})
+}
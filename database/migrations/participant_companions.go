@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"gorm.io/gorm"
+)
+
+func MigrateParticipantCompanionsTable(db *gorm.DB) error {
+	logconfig.SLog.Info("ParticipantCompanion tablosu migrate ediliyor...")
+	if err := db.AutoMigrate(&models.ParticipantCompanion{}); err != nil {
+		return err
+	}
+	logconfig.SLog.Info("ParticipantCompanion tablosu migrate işlemi tamamlandı.")
+	return nil
+}
@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"gorm.io/gorm"
+)
+
+func MigrateRSVPQuestionsTable(db *gorm.DB) error {
+	logconfig.SLog.Info("RSVPQuestion tablosu migrate ediliyor...")
+	if err := db.AutoMigrate(&models.RSVPQuestion{}, &models.RSVPAnswer{}); err != nil {
+		return err
+	}
+	logconfig.SLog.Info("RSVPQuestion tablosu migrate işlemi tamamlandı.")
+	return nil
+}
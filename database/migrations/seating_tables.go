@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"gorm.io/gorm"
+)
+
+func MigrateSeatingTablesTable(db *gorm.DB) error {
+	logconfig.SLog.Info("SeatingTable tablosu migrate ediliyor...")
+	if err := db.AutoMigrate(&models.SeatingTable{}); err != nil {
+		return err
+	}
+	logconfig.SLog.Info("SeatingTable tablosu migrate işlemi tamamlandı.")
+	return nil
+}
@@ -14,6 +14,9 @@ func MigrateInvitationsTable(db *gorm.DB) error {
 	if err := db.AutoMigrate(&models.Invitation{}); err != nil {
 		return errors.New("Invitations tablosu migrate edilemedi: " + err.Error())
 	}
+	if err := migrateInvitationSchedule(db); err != nil {
+		return errors.New("Invitations tarih alanları taşınamadı: " + err.Error())
+	}
 	logconfig.SLog.Info("Invitations tablosu migrate işlemi tamamlandı.")
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"davet.link/configs/sessionconfig"
 	"davet.link/models"
 	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/i18n"
 	"davet.link/pkg/renderer"
 	"davet.link/requests"
 	"davet.link/services"
@@ -170,6 +171,10 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 
 	sess.Set("user_id", user.ID)
 	sess.Set("user_type", string(user.Type))
+	if user.Locale != "" {
+		sess.Set(i18n.LocaleKey, user.Locale)
+		c.Locals(i18n.LocaleKey, user.Locale)
+	}
 	if err := sess.Save(); err != nil {
 		logconfig.Log.Error("Oturum kaydedilemedi",
 			zap.Uint("user_id", user.ID),
@@ -12,6 +12,7 @@ import (
 	"davet.link/configs/sessionconfig"
 	"davet.link/models"
 	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/i18n"
 	"davet.link/services"
 
 	"github.com/gofiber/fiber/v2"
@@ -121,6 +122,10 @@ func GoogleCallback(c *fiber.Ctx) error {
 	sess.Set("user_id", user.ID)
 	sess.Set("user_type", string(user.Type))
 	sess.Set("user_status", user.Status)
+	if user.Locale != "" {
+		sess.Set(i18n.LocaleKey, user.Locale)
+		c.Locals(i18n.LocaleKey, user.Locale)
+	}
 	if err = sess.Save(); err != nil {
 		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Oturum kaydedilemedi.")
 		return c.Redirect("/auth/login", fiber.StatusSeeOther)
@@ -1,10 +1,12 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"davet.link/configs/logconfig"
 	"davet.link/models"
+	"davet.link/pkg/flashmessages"
 	"davet.link/pkg/queryparams"
 	"davet.link/pkg/renderer"
 	"davet.link/requests"
@@ -105,6 +107,11 @@ func (h *DashboardCardHandler) CreateCard(c *fiber.Ctx) error {
 		card.CardSocialMedia = append(card.CardSocialMedia, models.CardSocialMedia{CardID: card.ID, SocialMediaID: smID, URL: ""})
 	}
 	if err := h.cardService.CreateCard(c.UserContext(), card); err != nil {
+		var serviceErr services.ServiceError
+		if errors.As(err, &serviceErr) {
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Kart oluşturulamadı: "+err.Error())
+			return c.Redirect(c.Path(), http.StatusSeeOther)
+		}
 		return c.Status(http.StatusInternalServerError).SendString("Kart oluşturulamadı")
 	}
 	return c.Redirect("/dashboard/cards", http.StatusFound)
@@ -152,6 +159,11 @@ func (h *DashboardCardHandler) UpdateCard(c *fiber.Ctx) error {
 		card.CardSocialMedia = append(card.CardSocialMedia, models.CardSocialMedia{CardID: uint(id), SocialMediaID: smID, URL: ""})
 	}
 	if err := h.cardService.UpdateCard(c.UserContext(), uint(id), card); err != nil {
+		var serviceErr services.ServiceError
+		if errors.As(err, &serviceErr) {
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Kart güncellenemedi: "+err.Error())
+			return c.Redirect(c.Path(), http.StatusSeeOther)
+		}
 		return c.Status(http.StatusInternalServerError).SendString("Kart güncellenemedi")
 	}
 	return c.Redirect("/dashboard/cards", http.StatusFound)
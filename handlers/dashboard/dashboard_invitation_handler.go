@@ -1,12 +1,19 @@
 package handlers
 
 import (
+	"bufio"
+	"bytes"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"davet.link/configs/logconfig"
 	"davet.link/models"
+	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/i18n"
 	"davet.link/pkg/queryparams"
 	"davet.link/pkg/renderer"
+	"davet.link/pkg/themes"
 	"davet.link/requests"
 	"davet.link/services"
 	"go.uber.org/zap"
@@ -18,6 +25,8 @@ type DashboardInvitationHandler struct {
 	invitationService services.IInvitationService
 	userService       services.IUserService
 	categoryService   services.IInvitationCategoryService
+	exportService     services.IParticipantExportService
+	questionService   services.IRSVPQuestionService
 }
 
 func NewDashboardInvitationHandler() *DashboardInvitationHandler {
@@ -25,6 +34,8 @@ func NewDashboardInvitationHandler() *DashboardInvitationHandler {
 		invitationService: services.NewInvitationService(),
 		userService:       services.NewUserService(),
 		categoryService:   services.NewInvitationCategoryService(),
+		exportService:     services.NewParticipantExportService(),
+		questionService:   services.NewRSVPQuestionService(),
 	}
 }
 
@@ -69,17 +80,17 @@ func (h *DashboardInvitationHandler) ShowCreateInvitation(c *fiber.Ctx) error {
 	usersResult, _ := h.userService.GetAllUsers(queryparams.ListParams{PerPage: 1000})
 	categoriesResult, _ := h.categoryService.GetAllCategories(queryparams.ListParams{PerPage: 1000})
 	return renderer.Render(c, "dashboard/invitations/create", "layouts/dashboard", fiber.Map{
-		"Title":      "Yeni Davetiye Oluştur",
-		"Users":      usersResult.Data,
-		"Categories": categoriesResult.Data,
+		"Title":        "Yeni Davetiye Oluştur",
+		"Users":        usersResult.Data,
+		"Categories":   categoriesResult.Data,
+		"Translations": services.InvitationTranslationsByLocale(nil),
+		"Themes":       themes.All(),
 	}, http.StatusOK)
 }
 
 func (h *DashboardInvitationHandler) CreateInvitation(c *fiber.Ctx) error {
-	if err := requests.ValidateInvitationRequest(c); err != nil {
-		return err
-	}
 	req := c.Locals("invitationRequest").(requests.InvitationRequest)
+	schedule := c.Locals("invitationSchedule").(requests.InvitationSchedule)
 	invitation := &models.Invitation{
 		InvitationKey: req.InvitationKey,
 		UserID:        req.UserID,
@@ -97,13 +108,23 @@ func (h *DashboardInvitationHandler) CreateInvitation(c *fiber.Ctx) error {
 		Note:          req.Note,
 		IsConfirmed:   req.IsConfirmed == "true",
 		IsParticipant: req.IsParticipant == "true",
+		IsPublic:      req.IsPublic == "true",
+		PrimaryLocale: req.PrimaryLocale,
+		Translations:  req.InvitationTranslations(),
 	}
+	invitation.StartsAt, invitation.EndsAt, invitation.AllDay = schedule.StartsAt, schedule.EndsAt, schedule.AllDay
+	invitation.TimeZone = req.TimeZone
 	invitation.InvitationDetail = &models.InvitationDetail{
 		Title:  req.DetailTitle,
 		Person: req.DetailPerson,
 	}
 	// Katılımcı ekleme kaldırıldı, sadece website tarafından eklenir
 	if err := h.invitationService.CreateInvitation(c.UserContext(), invitation); err != nil {
+		var serviceErr services.ServiceError
+		if errors.As(err, &serviceErr) {
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Davetiye oluşturulamadı: "+err.Error())
+			return c.Redirect(c.Path(), http.StatusSeeOther)
+		}
 		return c.Status(http.StatusInternalServerError).SendString("Davetiye oluşturulamadı")
 	}
 	return c.Redirect("/dashboard/invitations", http.StatusFound)
@@ -118,19 +139,19 @@ func (h *DashboardInvitationHandler) ShowUpdateInvitation(c *fiber.Ctx) error {
 	usersResult, _ := h.userService.GetAllUsers(queryparams.ListParams{PerPage: 1000})
 	categoriesResult, _ := h.categoryService.GetAllCategories(queryparams.ListParams{PerPage: 1000})
 	return renderer.Render(c, "dashboard/invitations/update", "layouts/dashboard", fiber.Map{
-		"Title":      "Davetiye Düzenle",
-		"Invitation": invitation,
-		"Users":      usersResult.Data,
-		"Categories": categoriesResult.Data,
+		"Title":        "Davetiye Düzenle",
+		"Invitation":   invitation,
+		"Users":        usersResult.Data,
+		"Categories":   categoriesResult.Data,
+		"Translations": services.InvitationTranslationsByLocale(invitation),
+		"Themes":       themes.All(),
 	}, http.StatusOK)
 }
 
 func (h *DashboardInvitationHandler) UpdateInvitation(c *fiber.Ctx) error {
 	id, _ := c.ParamsInt("id")
-	if err := requests.ValidateInvitationRequest(c); err != nil {
-		return err
-	}
 	req := c.Locals("invitationRequest").(requests.InvitationRequest)
+	schedule := c.Locals("invitationSchedule").(requests.InvitationSchedule)
 	invitation := &models.Invitation{
 		InvitationKey: req.InvitationKey,
 		UserID:        req.UserID,
@@ -148,13 +169,23 @@ func (h *DashboardInvitationHandler) UpdateInvitation(c *fiber.Ctx) error {
 		Note:          req.Note,
 		IsConfirmed:   req.IsConfirmed == "true",
 		IsParticipant: req.IsParticipant == "true",
+		IsPublic:      req.IsPublic == "true",
+		PrimaryLocale: req.PrimaryLocale,
+		Translations:  req.InvitationTranslations(),
 	}
+	invitation.StartsAt, invitation.EndsAt, invitation.AllDay = schedule.StartsAt, schedule.EndsAt, schedule.AllDay
+	invitation.TimeZone = req.TimeZone
 	invitation.InvitationDetail = &models.InvitationDetail{
 		Title:  req.DetailTitle,
 		Person: req.DetailPerson,
 	}
 	// Katılımcı ekleme kaldırıldı, sadece website tarafından eklenir
 	if err := h.invitationService.UpdateInvitation(c.UserContext(), uint(id), invitation); err != nil {
+		var serviceErr services.ServiceError
+		if errors.As(err, &serviceErr) {
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Davetiye güncellenemedi: "+err.Error())
+			return c.Redirect(c.Path(), http.StatusSeeOther)
+		}
 		return c.Status(http.StatusInternalServerError).SendString("Davetiye güncellenemedi")
 	}
 	return c.Redirect("/dashboard/invitations", http.StatusFound)
@@ -175,11 +206,70 @@ func (h *DashboardInvitationHandler) ListParticipants(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(http.StatusInternalServerError).SendString("Katılımcılar getirilemedi")
 	}
+	questions, err := h.questionService.GetQuestions(uint(invID))
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString("Katılımcılar getirilemedi")
+	}
 	return renderer.Render(c, "dashboard/invitations/participants", "layouts/dashboard", fiber.Map{
 		"Participants": participants,
+		"Questions":    questions,
+		"InvitationID": invID,
 	}, http.StatusOK)
 }
 
+// Katılımcı listesini CSV olarak indirme (dashboard)
+func (h *DashboardInvitationHandler) ExportParticipantsCSV(c *fiber.Ctx) error {
+	id, _ := c.ParamsInt("id")
+	invitation, err := h.invitationService.GetInvitationByID(c.UserContext(), uint(id))
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString("Davetiye bulunamadı")
+	}
+	participants, err := h.invitationService.GetParticipantsByInvitationID(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString("Katılımcılar getirilemedi")
+	}
+	questions, err := h.questionService.GetQuestions(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString("Katılımcılar getirilemedi")
+	}
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, "attachment; filename="+strconv.Quote("katilimcilar-"+invitation.InvitationKey+".csv"))
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := h.exportService.WriteCSV(w, participants, questions, i18n.DefaultLocale); err != nil {
+			logconfig.Log.Warn("Katılımcı listesi CSV olarak yazılamadı", zap.Uint("invitation_id", invitation.ID), zap.Error(err))
+		}
+		_ = w.Flush()
+	})
+	return nil
+}
+
+// Katılımcı listesini yazdırılabilir PDF olarak indirme (dashboard)
+func (h *DashboardInvitationHandler) ExportParticipantsPDF(c *fiber.Ctx) error {
+	id, _ := c.ParamsInt("id")
+	invitation, err := h.invitationService.GetInvitationByID(c.UserContext(), uint(id))
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString("Davetiye bulunamadı")
+	}
+	participants, err := h.invitationService.GetParticipantsByInvitationID(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString("Katılımcılar getirilemedi")
+	}
+	questions, err := h.questionService.GetQuestions(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString("Katılımcılar getirilemedi")
+	}
+	var buf bytes.Buffer
+	if err := h.exportService.WritePDF(&buf, invitation, participants, questions, i18n.DefaultLocale); err != nil {
+		logconfig.Log.Error("Katılımcı listesi PDF olarak oluşturulamadı", zap.Uint("invitation_id", invitation.ID), zap.Error(err))
+		return c.Status(http.StatusInternalServerError).SendString("Katılımcı listesi dışa aktarılamadı")
+	}
+	c.Set(fiber.HeaderContentType, "application/pdf")
+	c.Set(fiber.HeaderContentDisposition, "inline; filename="+strconv.Quote("katilimcilar-"+invitation.InvitationKey+".pdf"))
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Status(http.StatusOK).Send(buf.Bytes())
+}
+
 // Katılımcı güncelleme (dashboard)
 func (h *DashboardInvitationHandler) UpdateParticipant(c *fiber.Ctx) error {
 	id, _ := c.ParamsInt("id")
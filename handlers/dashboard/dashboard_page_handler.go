@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/queryparams"
+	"davet.link/pkg/renderer"
+	"davet.link/requests"
+	"davet.link/services"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+type DashboardPageHandler struct {
+	pageService services.IPageService
+}
+
+func NewDashboardPageHandler() *DashboardPageHandler {
+	return &DashboardPageHandler{pageService: services.NewPageService()}
+}
+
+func (h *DashboardPageHandler) ListPages(c *fiber.Ctx) error {
+	var params queryparams.ListParams
+	if err := c.QueryParser(&params); err != nil {
+		logconfig.Log.Warn("Sayfa listesi: Query parametreleri parse edilemedi, varsayılanlar kullanılıyor.", zap.Error(err))
+		params = queryparams.DefaultListParams()
+	}
+	// Sayfalarda "name" sütunu olmadığından ad filtresi kullanılmaz
+	params.Name = ""
+
+	if params.Page <= 0 {
+		params.Page = queryparams.DefaultPage
+	}
+	if params.PerPage <= 0 || params.PerPage > queryparams.MaxPerPage {
+		params.PerPage = queryparams.DefaultPerPage
+	}
+	if params.SortBy == "" {
+		params.SortBy = queryparams.DefaultSortBy
+	}
+	if params.OrderBy == "" {
+		params.OrderBy = queryparams.DefaultOrderBy
+	}
+
+	paginatedResult, dbErr := h.pageService.GetAllPages(params)
+
+	renderData := fiber.Map{
+		"Title":  "Sayfalar",
+		"Result": paginatedResult,
+		"Params": params,
+	}
+	if dbErr != nil {
+		logconfig.Log.Error("Sayfa listesi DB Hatası", zap.Error(dbErr))
+		renderData[renderer.FlashErrorKeyView] = "Sayfalar getirilirken bir hata oluştu."
+		renderData["Result"] = &queryparams.PaginatedResult{
+			Data: []models.Page{},
+			Meta: queryparams.PaginationMeta{
+				CurrentPage: params.Page, PerPage: params.PerPage,
+			},
+		}
+	}
+	return renderer.Render(c, "dashboard/pages/list", "layouts/dashboard", renderData, http.StatusOK)
+}
+
+func (h *DashboardPageHandler) ShowCreatePage(c *fiber.Ctx) error {
+	return renderer.Render(c, "dashboard/pages/create", "layouts/dashboard", fiber.Map{
+		"Title": "Yeni Sayfa Ekle",
+	})
+}
+
+func (h *DashboardPageHandler) CreatePage(c *fiber.Ctx) error {
+	req := c.Locals("pageRequest").(requests.PageRequest)
+	page := pageFromRequest(req)
+	if err := h.pageService.CreatePage(c.UserContext(), page); err != nil {
+		return renderPageFormError("dashboard/pages/create", "Yeni Sayfa Ekle", nil, req, "Sayfa oluşturulamadı: "+err.Error(), c)
+	}
+	_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Sayfa başarıyla oluşturuldu.")
+	return c.Redirect("/dashboard/pages", fiber.StatusFound)
+}
+
+func (h *DashboardPageHandler) ShowUpdatePage(c *fiber.Ctx) error {
+	id, _ := c.ParamsInt("id")
+	page, err := h.pageService.GetPageByID(uint(id))
+	if err != nil {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Sayfa bulunamadı.")
+		return c.Redirect("/dashboard/pages", fiber.StatusSeeOther)
+	}
+	return renderer.Render(c, "dashboard/pages/update", "layouts/dashboard", fiber.Map{
+		"Title": "Sayfa Düzenle",
+		"Page":  page,
+	})
+}
+
+func (h *DashboardPageHandler) UpdatePage(c *fiber.Ctx) error {
+	id, _ := c.ParamsInt("id")
+	req := c.Locals("pageRequest").(requests.PageRequest)
+	userID, _ := c.Locals("userID").(uint)
+	if err := h.pageService.UpdatePage(c.UserContext(), uint(id), pageFromRequest(req), userID); err != nil {
+		if errors.Is(err, services.ErrPageNotFound) {
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Sayfa bulunamadı.")
+			return c.Redirect("/dashboard/pages", fiber.StatusSeeOther)
+		}
+		return renderPageFormError("dashboard/pages/update", "Sayfa Düzenle", &models.Page{BaseModel: models.BaseModel{ID: uint(id)}}, req, "Sayfa güncellenemedi: "+err.Error(), c)
+	}
+	_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Sayfa başarıyla güncellendi.")
+	return c.Redirect("/dashboard/pages", fiber.StatusFound)
+}
+
+func (h *DashboardPageHandler) DeletePage(c *fiber.Ctx) error {
+	id, _ := c.ParamsInt("id")
+
+	if err := h.pageService.DeletePage(c.UserContext(), uint(id)); err != nil {
+		errMsg := "Sayfa silinemedi: " + err.Error()
+		if strings.Contains(c.Get("Accept"), "application/json") {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": errMsg})
+		}
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, errMsg)
+		return c.Redirect("/dashboard/pages", fiber.StatusSeeOther)
+	}
+
+	if strings.Contains(c.Get("Accept"), "application/json") {
+		return c.JSON(fiber.Map{"message": "Sayfa başarıyla silindi."})
+	}
+	_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Sayfa başarıyla silindi.")
+	return c.Redirect("/dashboard/pages", fiber.StatusFound)
+}
+
+func pageFromRequest(req requests.PageRequest) *models.Page {
+	return &models.Page{
+		Slug:            req.Slug,
+		Title:           strings.TrimSpace(req.Title),
+		Body:            req.Body,
+		MetaTitle:       strings.TrimSpace(req.MetaTitle),
+		MetaDescription: strings.TrimSpace(req.MetaDescription),
+		IsPublished:     req.IsPublished == "true",
+	}
+}
+
+func renderPageFormError(template string, title string, page *models.Page, req any, message string, c *fiber.Ctx) error {
+	return renderer.Render(c, template, "layouts/dashboard", fiber.Map{
+		"Title":                    title,
+		"Page":                     page,
+		renderer.FlashErrorKeyView: message,
+		renderer.FormDataKey:       req,
+	}, http.StatusBadRequest)
+}
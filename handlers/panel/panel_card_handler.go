@@ -1,10 +1,14 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
+	"davet.link/configs/envconfig"
 	"davet.link/configs/logconfig"
 	"davet.link/models"
+	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/i18n"
 	"davet.link/pkg/queryparams"
 	"davet.link/pkg/renderer"
 	"davet.link/requests"
@@ -19,6 +23,8 @@ type PanelCardHandler struct {
 	userService        services.IUserService
 	bankService        services.IBankService
 	socialMediaService services.ISocialMediaService
+	qrCodeService      services.IQRCodeService
+	analyticsService   services.IAnalyticsService
 }
 
 func NewPanelCardHandler() *PanelCardHandler {
@@ -27,6 +33,8 @@ func NewPanelCardHandler() *PanelCardHandler {
 		userService:        services.NewUserService(),
 		bankService:        services.NewBankService(),
 		socialMediaService: services.NewSocialMediaService(),
+		qrCodeService:      services.NewQRCodeService(),
+		analyticsService:   services.NewAnalyticsService(),
 	}
 }
 
@@ -108,7 +116,12 @@ func (h *PanelCardHandler) CreateCard(c *fiber.Ctx) error {
 		card.CardSocialMedia = append(card.CardSocialMedia, models.CardSocialMedia{CardID: card.ID, SocialMediaID: smID, URL: ""})
 	}
 	if err := h.cardService.CreateCard(c.UserContext(), card); err != nil {
-		return c.Status(http.StatusInternalServerError).SendString("Kart oluşturulamadı")
+		var serviceErr services.ServiceError
+		if errors.As(err, &serviceErr) {
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, i18n.Translate(c, "Kart oluşturulamadı")+": "+i18n.Translate(c, err.Error()))
+			return c.Redirect(c.Path(), http.StatusSeeOther)
+		}
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Kart oluşturulamadı"))
 	}
 	return c.Redirect("/panel/cards", http.StatusFound)
 }
@@ -118,7 +131,7 @@ func (h *PanelCardHandler) ShowUpdateCard(c *fiber.Ctx) error {
 	userID, _ := c.Locals("userID").(uint)
 	card, err := h.cardService.GetCardByID(c.UserContext(), uint(id))
 	if err != nil || card.UserID != userID {
-		return c.Status(http.StatusNotFound).SendString("Kart bulunamadı")
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Kart bulunamadı"))
 	}
 	banksResult, _ := h.bankService.GetAllBanks(queryparams.ListParams{PerPage: 1000})
 	socialMediasResult, _ := h.socialMediaService.GetAllSocialMedias(queryparams.ListParams{PerPage: 1000})
@@ -135,7 +148,7 @@ func (h *PanelCardHandler) UpdateCard(c *fiber.Ctx) error {
 	userID, _ := c.Locals("userID").(uint)
 	card, err := h.cardService.GetCardByID(c.UserContext(), uint(id))
 	if err != nil || card.UserID != userID {
-		return c.Status(http.StatusNotFound).SendString("Kart bulunamadı")
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Kart bulunamadı"))
 	}
 	if err := requests.ValidateCardRequest(c); err != nil {
 		return err
@@ -158,7 +171,12 @@ func (h *PanelCardHandler) UpdateCard(c *fiber.Ctx) error {
 		card.CardSocialMedia = append(card.CardSocialMedia, models.CardSocialMedia{CardID: uint(id), SocialMediaID: smID, URL: ""})
 	}
 	if err := h.cardService.UpdateCard(c.UserContext(), uint(id), card); err != nil {
-		return c.Status(http.StatusInternalServerError).SendString("Kart güncellenemedi")
+		var serviceErr services.ServiceError
+		if errors.As(err, &serviceErr) {
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, i18n.Translate(c, "Kart güncellenemedi")+": "+i18n.Translate(c, err.Error()))
+			return c.Redirect(c.Path(), http.StatusSeeOther)
+		}
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Kart güncellenemedi"))
 	}
 	return c.Redirect("/panel/cards", http.StatusFound)
 }
@@ -168,10 +186,33 @@ func (h *PanelCardHandler) DeleteCard(c *fiber.Ctx) error {
 	userID, _ := c.Locals("userID").(uint)
 	card, err := h.cardService.GetCardByID(c.UserContext(), uint(id))
 	if err != nil || card.UserID != userID {
-		return c.Status(http.StatusNotFound).SendString("Kart bulunamadı")
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Kart bulunamadı"))
 	}
 	if err := h.cardService.DeleteCard(c.UserContext(), uint(id)); err != nil {
-		return c.Status(http.StatusInternalServerError).SendString("Kart silinemedi")
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Kart silinemedi"))
 	}
 	return c.Redirect("/panel/cards", http.StatusFound)
 }
+
+func (h *PanelCardHandler) CardQRCode(c *fiber.Ctx) error {
+	id, _ := c.ParamsInt("id")
+	userID, _ := c.Locals("userID").(uint)
+	card, err := h.cardService.GetCardByID(c.UserContext(), uint(id))
+	if err != nil || card.UserID != userID {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Kart bulunamadı"))
+	}
+	content := envconfig.GetBaseURL() + "/@" + card.Slug
+	return sendQRCode(c, h.qrCodeService, content, "kartvizit-"+card.Slug)
+}
+
+// Kartvizit görüntülenme istatistikleri (panel)
+func (h *PanelCardHandler) CardStats(c *fiber.Ctx) error {
+	id, _ := c.ParamsInt("id")
+	userID, _ := c.Locals("userID").(uint)
+	card, err := h.cardService.GetCardByID(c.UserContext(), uint(id))
+	if err != nil || card.UserID != userID {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Kart bulunamadı"))
+	}
+	return renderPageViewStats(c, h.analyticsService, models.PageViewCard, card.ID,
+		"Kartvizit İstatistikleri", card.Name, envconfig.GetBaseURL()+"/@"+card.Slug)
+}
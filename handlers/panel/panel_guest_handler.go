@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+
+	"davet.link/configs/envconfig"
+	"davet.link/models"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/renderer"
+	"davet.link/requests"
+	"davet.link/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PanelGuestHandler struct {
+	invitationService services.IInvitationService
+	guestService      services.IGuestService
+}
+
+func NewPanelGuestHandler() *PanelGuestHandler {
+	return &PanelGuestHandler{
+		invitationService: services.NewInvitationService(),
+		guestService:      services.NewGuestService(),
+	}
+}
+
+// Davet listesi ve kişiye özel bağlantılar (panel)
+func (h *PanelGuestHandler) ListGuests(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	status := models.GuestStatus(c.Query("status"))
+	guests, summary, err := h.guestService.GetGuests(invitation.ID, status)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, err.Error()))
+	}
+	return renderer.Render(c, "panel/invitations/guests", "layouts/panel", fiber.Map{
+		"Title":      "Davet Listesi",
+		"Invitation": invitation,
+		"Guests":     guests,
+		"Summary":    summary,
+		"Status":     string(status),
+		"LinkPrefix": envconfig.GetBaseURL() + "/" + invitation.InvitationKey + "?g=",
+	}, http.StatusOK)
+}
+
+func (h *PanelGuestHandler) CreateGuest(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	guest := guestFromRequest(c)
+	guest.InvitationID = invitation.ID
+	err = h.guestService.CreateGuest(c.UserContext(), guest)
+	return redirectToInvitationTab(c, invitation.ID, "guests", err, "Davetli eklendi.", "Davet listesi güncellenemedi")
+}
+
+func (h *PanelGuestHandler) UpdateGuest(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	guestID, _ := c.ParamsInt("guestID")
+	err = h.guestService.UpdateGuest(c.UserContext(), invitation.ID, uint(guestID), guestFromRequest(c))
+	return redirectToInvitationTab(c, invitation.ID, "guests", err, "Davetli güncellendi.", "Davet listesi güncellenemedi")
+}
+
+func (h *PanelGuestHandler) DeleteGuest(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	guestID, _ := c.ParamsInt("guestID")
+	err = h.guestService.DeleteGuest(c.UserContext(), invitation.ID, uint(guestID))
+	return redirectToInvitationTab(c, invitation.ID, "guests", err, "Davetli silindi.", "Davet listesi güncellenemedi")
+}
+
+func guestFromRequest(c *fiber.Ctx) *models.Guest {
+	req := c.Locals("guestRequest").(requests.GuestRequest)
+	return &models.Guest{
+		Name:        req.Name,
+		PhoneNumber: req.PhoneNumber,
+	}
+}
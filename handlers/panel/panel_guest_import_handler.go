@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/renderer"
+	"davet.link/requests"
+	"davet.link/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PanelGuestImportHandler struct {
+	invitationService services.IInvitationService
+	importService     services.IGuestImportService
+}
+
+func NewPanelGuestImportHandler() *PanelGuestImportHandler {
+	return &PanelGuestImportHandler{
+		invitationService: services.NewInvitationService(),
+		importService:     services.NewGuestImportService(),
+	}
+}
+
+// Davet listesini CSV veya vCard dosyasından içe aktarma (panel)
+func (h *PanelGuestImportHandler) ShowImport(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	return renderer.Render(c, "panel/invitations/guest_import", "layouts/panel", fiber.Map{
+		"Title":      "Davetli İçe Aktar",
+		"Invitation": invitation,
+	}, http.StatusOK)
+}
+
+// PreviewImport, yüklenen dosyayı okuyup sütun eşleme ve önizleme adımını gösterir.
+func (h *PanelGuestImportHandler) PreviewImport(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	file, err := c.FormFile("file")
+	if err != nil {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Lütfen bir dosya seçin.")
+		return c.Redirect(c.Path(), http.StatusSeeOther)
+	}
+	src, err := file.Open()
+	if err != nil {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, services.ErrGuestImportInvalid.Error())
+		return c.Redirect(c.Path(), http.StatusSeeOther)
+	}
+	defer src.Close()
+
+	preview, err := h.importService.PreviewFile(file.Filename, file.Size, src)
+	if err != nil {
+		return h.importFailed(c, err)
+	}
+	return renderer.Render(c, "panel/invitations/guest_import", "layouts/panel", fiber.Map{
+		"Title":      "Davetli İçe Aktar",
+		"Invitation": invitation,
+		"Preview":    preview,
+	}, http.StatusOK)
+}
+
+// ConfirmImport, seçilen sütun eşlemesiyle satırları davet listesine ekler ve raporu gösterir.
+func (h *PanelGuestImportHandler) ConfirmImport(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	req := c.Locals("guestImportRequest").(requests.GuestImportRequest)
+	result, err := h.importService.ImportGuests(c.UserContext(), invitation.ID, req.Data, services.GuestColumnMapping{
+		NameColumn:    req.NameColumn,
+		SurnameColumn: req.SurnameColumn,
+		PhoneColumn:   req.PhoneColumn,
+		HasHeader:     req.HasHeader,
+	})
+	if err != nil {
+		return h.importFailed(c, err)
+	}
+	return renderer.Render(c, "panel/invitations/guest_import", "layouts/panel", fiber.Map{
+		"Title":      "Davetli İçe Aktar",
+		"Invitation": invitation,
+		"Result":     result,
+	}, http.StatusOK)
+}
+
+func (h *PanelGuestImportHandler) importFailed(c *fiber.Ctx, err error) error {
+	var serviceErr services.ServiceError
+	if !errors.As(err, &serviceErr) {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Davetliler içe aktarılamadı"))
+	}
+	_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, err.Error())
+	return c.Redirect("/panel/invitations/guests/"+c.Params("id")+"/import", http.StatusSeeOther)
+}
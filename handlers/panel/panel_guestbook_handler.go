@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+
+	"davet.link/models"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/renderer"
+	"davet.link/requests"
+	"davet.link/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PanelGuestbookHandler struct {
+	invitationService services.IInvitationService
+	guestbookService  services.IGuestbookService
+}
+
+func NewPanelGuestbookHandler() *PanelGuestbookHandler {
+	return &PanelGuestbookHandler{
+		invitationService: services.NewInvitationService(),
+		guestbookService:  services.NewGuestbookService(),
+	}
+}
+
+// Davetiye defteri mesajları (panel)
+func (h *PanelGuestbookHandler) ListEntries(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	status := models.GuestbookStatus(c.Query("status"))
+	entries, err := h.guestbookService.GetEntries(invitation.ID, status)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).SendString(i18n.Translate(c, err.Error()))
+	}
+	counts, err := h.guestbookService.GetCounts(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, err.Error()))
+	}
+	return renderer.Render(c, "panel/invitations/guestbook", "layouts/panel", fiber.Map{
+		"Title":      "Anı Defteri",
+		"Invitation": invitation,
+		"Entries":    entries,
+		"Counts":     counts,
+		"Status":     string(status),
+	}, http.StatusOK)
+}
+
+func (h *PanelGuestbookHandler) UpdateEntryStatus(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	entryID, _ := c.ParamsInt("entryID")
+	req := c.Locals("guestbookStatusRequest").(requests.GuestbookStatusRequest)
+	err = h.guestbookService.SetEntryStatus(c.UserContext(), invitation.ID, uint(entryID), models.GuestbookStatus(req.Status))
+	return redirectToInvitationTab(c, invitation.ID, "guestbook", err, "Mesaj güncellendi.", "Mesaj güncellenemedi")
+}
+
+func (h *PanelGuestbookHandler) DeleteEntry(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	entryID, _ := c.ParamsInt("entryID")
+	err = h.guestbookService.DeleteEntry(c.UserContext(), invitation.ID, uint(entryID))
+	return redirectToInvitationTab(c, invitation.ID, "guestbook", err, "Mesaj silindi.", "Mesaj güncellenemedi")
+}
+
+func (h *PanelGuestbookHandler) ExportEntries(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	var buf bytes.Buffer
+	if err := h.guestbookService.ExportEntries(&buf, invitation.ID, i18n.FromCtx(c)); err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Mesajlar dışa aktarılamadı"))
+	}
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, "attachment; filename="+strconv.Quote("ani-defteri-"+invitation.InvitationKey+".csv"))
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Status(http.StatusOK).Send(buf.Bytes())
+}
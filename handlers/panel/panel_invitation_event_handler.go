@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"net/http"
+
+	"davet.link/models"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/renderer"
+	"davet.link/requests"
+	"davet.link/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PanelInvitationEventHandler struct {
+	invitationService services.IInvitationService
+	eventService      services.IInvitationEventService
+}
+
+func NewPanelInvitationEventHandler() *PanelInvitationEventHandler {
+	return &PanelInvitationEventHandler{
+		invitationService: services.NewInvitationService(),
+		eventService:      services.NewInvitationEventService(),
+	}
+}
+
+// Davetiye programı (panel)
+func (h *PanelInvitationEventHandler) ShowProgramme(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	events, err := h.eventService.GetEvents(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, err.Error()))
+	}
+	return renderer.Render(c, "panel/invitations/programme", "layouts/panel", fiber.Map{
+		"Title":      "Davetiye Programı",
+		"Invitation": invitation,
+		"Events":     events,
+	}, http.StatusOK)
+}
+
+func (h *PanelInvitationEventHandler) CreateEvent(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	event := invitationEventFromRequest(c, invitation)
+	event.InvitationID = invitation.ID
+	err = h.eventService.CreateEvent(c.UserContext(), event)
+	return redirectToInvitationTab(c, invitation.ID, "programme", err, "Program bölümü eklendi.", "Program güncellenemedi")
+}
+
+func (h *PanelInvitationEventHandler) UpdateEvent(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	eventID, _ := c.ParamsInt("eventID")
+	err = h.eventService.UpdateEvent(c.UserContext(), invitation.ID, uint(eventID), invitationEventFromRequest(c, invitation))
+	return redirectToInvitationTab(c, invitation.ID, "programme", err, "Program bölümü güncellendi.", "Program güncellenemedi")
+}
+
+func (h *PanelInvitationEventHandler) DeleteEvent(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	eventID, _ := c.ParamsInt("eventID")
+	err = h.eventService.DeleteEvent(c.UserContext(), invitation.ID, uint(eventID))
+	return redirectToInvitationTab(c, invitation.ID, "programme", err, "Program bölümü silindi.", "Program güncellenemedi")
+}
+
+func invitationEventFromRequest(c *fiber.Ctx, invitation *models.Invitation) *models.InvitationEvent {
+	req := c.Locals("invitationEventRequest").(requests.InvitationEventRequest)
+	// Yazım ValidateInvitationEventRequest'te denetlendiğinden burada hata beklenmez
+	startsAt, endsAt, _ := req.Schedule(services.InvitationLocation(invitation))
+	return &models.InvitationEvent{
+		Name:     req.Name,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Venue:    req.Venue,
+		Address:  req.Address,
+		Location: req.Location,
+	}
+}
@@ -1,10 +1,19 @@
 package handlers
 
 import (
+	"bufio"
+	"bytes"
+	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
+	"davet.link/configs/envconfig"
 	"davet.link/configs/logconfig"
 	"davet.link/models"
+	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/i18n"
 	"davet.link/pkg/queryparams"
 	"davet.link/pkg/renderer"
 	"davet.link/requests"
@@ -18,6 +27,12 @@ type PanelInvitationHandler struct {
 	invitationService services.IInvitationService
 	userService       services.IUserService
 	categoryService   services.IInvitationCategoryService
+	calendarService   services.ICalendarService
+	qrCodeService     services.IQRCodeService
+	analyticsService  services.IAnalyticsService
+	themeService      services.IThemeService
+	exportService     services.IParticipantExportService
+	questionService   services.IRSVPQuestionService
 }
 
 func NewPanelInvitationHandler() *PanelInvitationHandler {
@@ -25,6 +40,12 @@ func NewPanelInvitationHandler() *PanelInvitationHandler {
 		invitationService: services.NewInvitationService(),
 		userService:       services.NewUserService(),
 		categoryService:   services.NewInvitationCategoryService(),
+		calendarService:   services.NewCalendarService(),
+		qrCodeService:     services.NewQRCodeService(),
+		analyticsService:  services.NewAnalyticsService(),
+		themeService:      services.NewThemeService(),
+		exportService:     services.NewParticipantExportService(),
+		questionService:   services.NewRSVPQuestionService(),
 	}
 }
 
@@ -62,6 +83,13 @@ func (h *PanelInvitationHandler) ListInvitations(c *fiber.Ctx) error {
 			},
 		}
 	}
+	userID, _ := c.Locals("userID").(uint)
+	renderData["UserID"] = userID
+	if token, err := h.calendarService.GetOrCreateCalendarToken(c.UserContext(), userID); err == nil {
+		feedURL := envconfig.GetBaseURL() + "/calendar/" + token + ".ics"
+		renderData["CalendarFeedURL"] = feedURL
+		renderData["CalendarWebcalURL"] = "webcal://" + strings.TrimPrefix(strings.TrimPrefix(feedURL, "https://"), "http://")
+	}
 	return renderer.Render(c, "panel/invitations/list", "layouts/panel", renderData, http.StatusOK)
 }
 
@@ -76,10 +104,8 @@ func (h *PanelInvitationHandler) ShowCreateInvitation(c *fiber.Ctx) error {
 }
 
 func (h *PanelInvitationHandler) CreateInvitation(c *fiber.Ctx) error {
-	if err := requests.ValidateInvitationRequest(c); err != nil {
-		return err
-	}
 	req := c.Locals("invitationRequest").(requests.InvitationRequest)
+	schedule := c.Locals("invitationSchedule").(requests.InvitationSchedule)
 	invitation := &models.Invitation{
 		InvitationKey: req.InvitationKey,
 		UserID:        req.UserID,
@@ -97,14 +123,24 @@ func (h *PanelInvitationHandler) CreateInvitation(c *fiber.Ctx) error {
 		Note:          req.Note,
 		IsConfirmed:   req.IsConfirmed == "true",
 		IsParticipant: req.IsParticipant == "true",
+		IsPublic:      req.IsPublic == "true",
+		PrimaryLocale: req.PrimaryLocale,
+		Translations:  req.InvitationTranslations(),
 	}
+	invitation.StartsAt, invitation.EndsAt, invitation.AllDay = schedule.StartsAt, schedule.EndsAt, schedule.AllDay
+	invitation.TimeZone = req.TimeZone
 	invitation.InvitationDetail = &models.InvitationDetail{
 		Title:  req.DetailTitle,
 		Person: req.DetailPerson,
 	}
 	// Katılımcı ekleme kaldırıldı, sadece website tarafından eklenir
 	if err := h.invitationService.CreateInvitation(c.UserContext(), invitation); err != nil {
-		return c.Status(http.StatusInternalServerError).SendString("Davetiye oluşturulamadı")
+		var serviceErr services.ServiceError
+		if errors.As(err, &serviceErr) {
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, i18n.Translate(c, "Davetiye oluşturulamadı")+": "+i18n.Translate(c, err.Error()))
+			return c.Redirect(c.Path(), http.StatusSeeOther)
+		}
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Davetiye oluşturulamadı"))
 	}
 	return c.Redirect("/panel/invitations", http.StatusFound)
 }
@@ -113,7 +149,7 @@ func (h *PanelInvitationHandler) ShowUpdateInvitation(c *fiber.Ctx) error {
 	id, _ := c.ParamsInt("id")
 	invitation, err := h.invitationService.GetInvitationByID(c.UserContext(), uint(id))
 	if err != nil {
-		return c.Status(http.StatusNotFound).SendString("Davetiye bulunamadı")
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
 	}
 	usersResult, _ := h.userService.GetAllUsers(queryparams.ListParams{PerPage: 1000})
 	categoriesResult, _ := h.categoryService.GetAllCategories(queryparams.ListParams{PerPage: 1000})
@@ -127,10 +163,8 @@ func (h *PanelInvitationHandler) ShowUpdateInvitation(c *fiber.Ctx) error {
 
 func (h *PanelInvitationHandler) UpdateInvitation(c *fiber.Ctx) error {
 	id, _ := c.ParamsInt("id")
-	if err := requests.ValidateInvitationRequest(c); err != nil {
-		return err
-	}
 	req := c.Locals("invitationRequest").(requests.InvitationRequest)
+	schedule := c.Locals("invitationSchedule").(requests.InvitationSchedule)
 	invitation := &models.Invitation{
 		InvitationKey: req.InvitationKey,
 		UserID:        req.UserID,
@@ -148,14 +182,24 @@ func (h *PanelInvitationHandler) UpdateInvitation(c *fiber.Ctx) error {
 		Note:          req.Note,
 		IsConfirmed:   req.IsConfirmed == "true",
 		IsParticipant: req.IsParticipant == "true",
+		IsPublic:      req.IsPublic == "true",
+		PrimaryLocale: req.PrimaryLocale,
+		Translations:  req.InvitationTranslations(),
 	}
+	invitation.StartsAt, invitation.EndsAt, invitation.AllDay = schedule.StartsAt, schedule.EndsAt, schedule.AllDay
+	invitation.TimeZone = req.TimeZone
 	invitation.InvitationDetail = &models.InvitationDetail{
 		Title:  req.DetailTitle,
 		Person: req.DetailPerson,
 	}
 	// Katılımcı ekleme kaldırıldı, sadece website tarafından eklenir
 	if err := h.invitationService.UpdateInvitation(c.UserContext(), uint(id), invitation); err != nil {
-		return c.Status(http.StatusInternalServerError).SendString("Davetiye güncellenemedi")
+		var serviceErr services.ServiceError
+		if errors.As(err, &serviceErr) {
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, i18n.Translate(c, "Davetiye güncellenemedi")+": "+i18n.Translate(c, err.Error()))
+			return c.Redirect(c.Path(), http.StatusSeeOther)
+		}
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Davetiye güncellenemedi"))
 	}
 	return c.Redirect("/panel/invitations", http.StatusFound)
 }
@@ -163,23 +207,89 @@ func (h *PanelInvitationHandler) UpdateInvitation(c *fiber.Ctx) error {
 func (h *PanelInvitationHandler) DeleteInvitation(c *fiber.Ctx) error {
 	id, _ := c.ParamsInt("id")
 	if err := h.invitationService.DeleteInvitation(c.UserContext(), uint(id)); err != nil {
-		return c.Status(http.StatusInternalServerError).SendString("Davetiye silinemedi")
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Davetiye silinemedi"))
 	}
 	return c.Redirect("/panel/invitations", http.StatusFound)
 }
 
 // Katılımcı listesi (panel)
 func (h *PanelInvitationHandler) ListParticipants(c *fiber.Ctx) error {
-	invID, _ := c.ParamsInt("id")
-	participants, err := h.invitationService.GetParticipantsByInvitationID(uint(invID))
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	status := services.ParseRSVPStatus(c.Query("status"))
+	participants, summary, err := h.invitationService.GetParticipantOverview(invitation.ID, status)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Katılımcılar getirilemedi"))
+	}
+	history, err := h.invitationService.GetParticipantHistory(invitation.ID)
 	if err != nil {
-		return c.Status(http.StatusInternalServerError).SendString("Katılımcılar getirilemedi")
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Katılımcılar getirilemedi"))
 	}
 	return renderer.Render(c, "panel/invitations/participants", "layouts/panel", fiber.Map{
 		"Participants": participants,
+		"Summary":      summary,
+		"History":      history,
+		"Status":       string(status),
+		"InvitationID": invitation.ID,
+		"Capacity":     invitation.MaxHeadcount,
 	}, http.StatusOK)
 }
 
+// Katılımcı listesini CSV olarak indirme (panel)
+func (h *PanelInvitationHandler) ExportParticipantsCSV(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	participants, err := h.invitationService.GetParticipantsByInvitationID(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Katılımcılar getirilemedi"))
+	}
+	questions, err := h.questionService.GetQuestions(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Katılımcılar getirilemedi"))
+	}
+	locale := i18n.FromCtx(c)
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, "attachment; filename="+strconv.Quote("katilimcilar-"+invitation.InvitationKey+".csv"))
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	// Satırlar yanıt gövdesine akıtılır; yazma başladıktan sonra durum kodu değiştirilemeyeceği için hata yalnızca loglanır
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := h.exportService.WriteCSV(w, participants, questions, locale); err != nil {
+			logconfig.Log.Warn("Katılımcı listesi CSV olarak yazılamadı", zap.Uint("invitation_id", invitation.ID), zap.Error(err))
+		}
+		_ = w.Flush()
+	})
+	return nil
+}
+
+// Katılımcı listesini yazdırılabilir PDF olarak indirme (panel)
+func (h *PanelInvitationHandler) ExportParticipantsPDF(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	participants, err := h.invitationService.GetParticipantsByInvitationID(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Katılımcılar getirilemedi"))
+	}
+	questions, err := h.questionService.GetQuestions(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Katılımcılar getirilemedi"))
+	}
+	var buf bytes.Buffer
+	if err := h.exportService.WritePDF(&buf, invitation, participants, questions, i18n.FromCtx(c)); err != nil {
+		logconfig.Log.Error("Katılımcı listesi PDF olarak oluşturulamadı", zap.Uint("invitation_id", invitation.ID), zap.Error(err))
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Katılımcı listesi dışa aktarılamadı"))
+	}
+	c.Set(fiber.HeaderContentType, "application/pdf")
+	c.Set(fiber.HeaderContentDisposition, "inline; filename="+strconv.Quote("katilimcilar-"+invitation.InvitationKey+".pdf"))
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Status(http.StatusOK).Send(buf.Bytes())
+}
+
 // Katılımcı güncelleme (panel)
 func (h *PanelInvitationHandler) UpdateParticipant(c *fiber.Ctx) error {
 	id, _ := c.ParamsInt("id")
@@ -193,7 +303,7 @@ func (h *PanelInvitationHandler) UpdateParticipant(c *fiber.Ctx) error {
 		GuestCount:  req.GuestCount,
 	}
 	if err := h.invitationService.UpdateParticipant(uint(id), participant); err != nil {
-		return c.Status(500).SendString("Katılımcı güncellenemedi")
+		return c.Status(500).SendString(i18n.Translate(c, "Katılımcı güncellenemedi"))
 	}
 	return c.Redirect("/panel/invitations/participants/"+c.Query("invitation_id"), 302)
 }
@@ -203,7 +313,151 @@ func (h *PanelInvitationHandler) DeleteParticipant(c *fiber.Ctx) error {
 	id, _ := c.ParamsInt("id")
 	invID := c.Query("invitation_id")
 	if err := h.invitationService.DeleteParticipant(uint(id)); err != nil {
-		return c.Status(500).SendString("Katılımcı silinemedi")
+		return c.Status(500).SendString(i18n.Translate(c, "Katılımcı silinemedi"))
 	}
 	return c.Redirect("/panel/invitations/participants/"+invID, 302)
 }
+
+// Davetiye bağlantısı için QR kod (panel)
+func (h *PanelInvitationHandler) InvitationQRCode(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	content := envconfig.GetBaseURL() + "/" + invitation.InvitationKey
+	return sendQRCode(c, h.qrCodeService, content, "davetiye-"+invitation.InvitationKey)
+}
+
+// Davetiye görüntülenme istatistikleri (panel)
+func (h *PanelInvitationHandler) InvitationStats(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	return renderPageViewStats(c, h.analyticsService, models.PageViewInvitation, invitation.ID,
+		"Davetiye İstatistikleri", invitation.Title, envconfig.GetBaseURL()+"/"+invitation.InvitationKey)
+}
+
+// Davetiye teması seçimi (panel)
+func (h *PanelInvitationHandler) ShowInvitationTheme(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	return renderer.Render(c, "panel/invitations/theme", "layouts/panel", fiber.Map{
+		"Title":      "Davetiye Teması",
+		"Invitation": invitation,
+		"Themes":     h.themeService.GetThemesForInvitation(invitation),
+		"Current":    h.themeService.GetInvitationTheme(invitation).Name,
+		"Category":   services.InvitationCategoryTemplate(invitation),
+	}, http.StatusOK)
+}
+
+func (h *PanelInvitationHandler) UpdateInvitationTheme(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	req := c.Locals("invitationThemeRequest").(requests.InvitationThemeRequest)
+	if err := h.invitationService.UpdateInvitationTheme(c.UserContext(), invitation.ID, req.Theme); err != nil {
+		var serviceErr services.ServiceError
+		if errors.As(err, &serviceErr) {
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, i18n.Translate(c, "Tema kaydedilemedi")+": "+i18n.Translate(c, err.Error()))
+			return c.Redirect(c.Path(), http.StatusSeeOther)
+		}
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Tema kaydedilemedi"))
+	}
+	_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Davetiye teması kaydedildi.")
+	return c.Redirect(c.Path(), http.StatusSeeOther)
+}
+
+// Kontenjan ve son bildirim tarihi ayarları (panel)
+func (h *PanelInvitationHandler) ShowRSVPSettings(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	availability, err := h.invitationService.GetRSVPAvailability(invitation)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Katılım ayarları getirilemedi"))
+	}
+	return renderer.Render(c, "panel/invitations/rsvp", "layouts/panel", fiber.Map{
+		"Title":        "Katılım Ayarları",
+		"Invitation":   invitation,
+		"Availability": availability,
+	}, http.StatusOK)
+}
+
+func (h *PanelInvitationHandler) UpdateRSVPSettings(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	req := c.Locals("invitationRSVPRequest").(requests.InvitationRSVPRequest)
+	deadline, err := req.Deadline(services.InvitationLocation(invitation))
+	if err == nil {
+		err = h.invitationService.UpdateRSVPSettings(c.UserContext(), invitation.ID, services.RSVPSettings{
+			MaxHeadcount:  req.MaxHeadcount,
+			MaxCompanions: req.MaxCompanions,
+			Deadline:      deadline,
+		})
+	}
+	if err != nil {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, i18n.Translate(c, "Katılım ayarları kaydedilemedi")+": "+i18n.Translate(c, err.Error()))
+		return c.Redirect(c.Path(), http.StatusSeeOther)
+	}
+	_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Katılım ayarları kaydedildi.")
+	return c.Redirect(c.Path(), http.StatusSeeOther)
+}
+
+// Temanın örnek içerikle önizlemesi (panel)
+func (h *PanelInvitationHandler) PreviewTheme(c *fiber.Ctx) error {
+	preview, err := h.themeService.GetPreview(c.Params("name"), c.Query("category"), i18n.FromCtx(c))
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, err.Error()))
+	}
+	return renderer.Render(c, preview.View, "layouts/invitation", fiber.Map{
+		"Title":      preview.Invitation.Title,
+		"Invitation": preview.Invitation,
+		"Detail":     preview.Detail,
+		"Theme":      preview.Theme,
+	}, http.StatusOK)
+}
+
+// ownedInvitation, adresteki davetiyeyi yalnızca oturumdaki kullanıcıya aitse döndürür.
+func ownedInvitation(c *fiber.Ctx, invitationService services.IInvitationService) (*models.Invitation, error) {
+	id, _ := c.ParamsInt("id")
+	userID, _ := c.Locals("userID").(uint)
+	invitation, err := invitationService.GetInvitationByID(c.UserContext(), uint(id))
+	if err != nil {
+		return nil, err
+	}
+	if invitation.UserID != userID {
+		return nil, services.ErrInvitationNotFound
+	}
+	return invitation, nil
+}
+
+// invitationTabPath, davetiyenin paneldeki alt sayfasının adresidir (ör. /panel/invitations/guests/3).
+func invitationTabPath(invitationID uint, tab string) string {
+	return "/panel/invitations/" + tab + "/" + strconv.FormatUint(uint64(invitationID), 10)
+}
+
+// redirectToInvitationTab, alt sayfadaki bir değişikliğin sonucunu flash mesajla bildirip sayfaya döner.
+// ServiceError kullanıcıya gösterilir; beklenmeyen hatada failure mesajıyla 500 döner. Listedeki durum filtresi korunur.
+func redirectToInvitationTab(c *fiber.Ctx, invitationID uint, tab string, err error, success, failure string) error {
+	redirectPath := invitationTabPath(invitationID, tab)
+	if status := c.Query("status"); status != "" {
+		redirectPath += "?status=" + url.QueryEscape(status)
+	}
+	if err != nil {
+		var serviceErr services.ServiceError
+		if !errors.As(err, &serviceErr) {
+			return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, failure))
+		}
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, err.Error())
+		return c.Redirect(redirectPath, http.StatusSeeOther)
+	}
+	_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, success)
+	return c.Redirect(redirectPath, http.StatusSeeOther)
+}
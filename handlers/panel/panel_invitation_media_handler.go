@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/renderer"
+	"davet.link/requests"
+	"davet.link/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Galeri yükleme formundaki dosya alanının adı
+const mediaFormField = "photos"
+
+type PanelInvitationMediaHandler struct {
+	invitationService services.IInvitationService
+	mediaService      services.IInvitationMediaService
+}
+
+func NewPanelInvitationMediaHandler() *PanelInvitationMediaHandler {
+	return &PanelInvitationMediaHandler{
+		invitationService: services.NewInvitationService(),
+		mediaService:      services.NewInvitationMediaService(),
+	}
+}
+
+// Davetiye fotoğraf galerisi (panel)
+func (h *PanelInvitationMediaHandler) ShowGallery(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	media, err := h.mediaService.GetMedia(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, err.Error()))
+	}
+	return renderer.Render(c, "panel/invitations/gallery", "layouts/panel", fiber.Map{
+		"Title":      "Fotoğraf Galerisi",
+		"Invitation": invitation,
+		"Media":      media,
+	}, http.StatusOK)
+}
+
+func (h *PanelInvitationMediaHandler) UploadMedia(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	form, err := c.MultipartForm()
+	if err != nil {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Geçersiz istek formatı")
+		return c.Redirect(invitationTabPath(invitation.ID, "gallery"), http.StatusSeeOther)
+	}
+	saved, err := h.mediaService.UploadMedia(c.UserContext(), invitation.ID, form.File[mediaFormField])
+	if err != nil {
+		var serviceErr services.ServiceError
+		if !errors.As(err, &serviceErr) {
+			return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Fotoğraflar yüklenemedi"))
+		}
+		message := i18n.Translate(c, err.Error())
+		if saved > 0 {
+			message = i18n.Translate(c, "%d fotoğraf yüklendi, kalanlar yüklenemedi", saved) + ": " + message
+		}
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, message)
+		return c.Redirect(invitationTabPath(invitation.ID, "gallery"), http.StatusSeeOther)
+	}
+	_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, i18n.Translate(c, "%d fotoğraf yüklendi.", saved))
+	return c.Redirect(invitationTabPath(invitation.ID, "gallery"), http.StatusSeeOther)
+}
+
+func (h *PanelInvitationMediaHandler) UpdateCaption(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	mediaID, _ := c.ParamsInt("mediaID")
+	req := c.Locals("invitationMediaCaptionRequest").(requests.InvitationMediaCaptionRequest)
+	err = h.mediaService.UpdateCaption(c.UserContext(), invitation.ID, uint(mediaID), req.Caption)
+	return redirectToInvitationTab(c, invitation.ID, "gallery", err, "Açıklama kaydedildi.", "Galeri güncellenemedi")
+}
+
+func (h *PanelInvitationMediaHandler) MoveMedia(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	mediaID, _ := c.ParamsInt("mediaID")
+	req := c.Locals("invitationMediaMoveRequest").(requests.InvitationMediaMoveRequest)
+	err = h.mediaService.MoveMedia(c.UserContext(), invitation.ID, uint(mediaID), req.Direction)
+	return redirectToInvitationTab(c, invitation.ID, "gallery", err, "Galeri sırası güncellendi.", "Galeri güncellenemedi")
+}
+
+func (h *PanelInvitationMediaHandler) DeleteMedia(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	mediaID, _ := c.ParamsInt("mediaID")
+	err = h.mediaService.DeleteMedia(c.UserContext(), invitation.ID, uint(mediaID))
+	return redirectToInvitationTab(c, invitation.ID, "gallery", err, "Fotoğraf silindi.", "Galeri güncellenemedi")
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/qrcode"
+	"davet.link/requests"
+	"davet.link/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// sendQRCode, requests.ValidateQRCodeRequest ile doğrulanan seçeneklerle QR kodu döndürür.
+func sendQRCode(c *fiber.Ctx, qrCodeService services.IQRCodeService, content, filename string) error {
+	req, _ := c.Locals("qrCodeRequest").(requests.QRCodeRequest)
+
+	level, _ := qrcode.ParseLevel(req.Level)
+	format := services.QRCodeFormatPNG
+	if req.Format == services.QRCodeFormatSVG {
+		format = services.QRCodeFormatSVG
+	}
+
+	data, contentType, err := qrCodeService.Generate(content, services.QRCodeOptions{
+		Format:   format,
+		Size:     req.Size,
+		Level:    level,
+		WithLogo: req.Logo,
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "QR kod oluşturulamadı"))
+	}
+
+	disposition := "inline"
+	if req.Download {
+		disposition = "attachment"
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderContentDisposition, disposition+"; filename="+strconv.Quote(filename+"."+format))
+	c.Set(fiber.HeaderCacheControl, "private, max-age=300")
+	return c.Status(http.StatusOK).Send(data)
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"net/http"
+
+	"davet.link/models"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/renderer"
+	"davet.link/requests"
+	"davet.link/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PanelRSVPQuestionHandler struct {
+	invitationService services.IInvitationService
+	questionService   services.IRSVPQuestionService
+}
+
+func NewPanelRSVPQuestionHandler() *PanelRSVPQuestionHandler {
+	return &PanelRSVPQuestionHandler{
+		invitationService: services.NewInvitationService(),
+		questionService:   services.NewRSVPQuestionService(),
+	}
+}
+
+// Katılım formu soruları (panel)
+func (h *PanelRSVPQuestionHandler) ShowQuestions(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	questions, err := h.questionService.GetQuestions(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, err.Error()))
+	}
+	return renderer.Render(c, "panel/invitations/questions", "layouts/panel", fiber.Map{
+		"Title":      "Katılım Soruları",
+		"Invitation": invitation,
+		"Questions":  questions,
+	}, http.StatusOK)
+}
+
+func (h *PanelRSVPQuestionHandler) CreateQuestion(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	question := rsvpQuestionFromRequest(c)
+	question.InvitationID = invitation.ID
+	err = h.questionService.CreateQuestion(c.UserContext(), question)
+	return redirectToInvitationTab(c, invitation.ID, "questions", err, "Soru eklendi.", "Katılım soruları güncellenemedi")
+}
+
+func (h *PanelRSVPQuestionHandler) UpdateQuestion(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	questionID, _ := c.ParamsInt("questionID")
+	err = h.questionService.UpdateQuestion(c.UserContext(), invitation.ID, uint(questionID), rsvpQuestionFromRequest(c))
+	return redirectToInvitationTab(c, invitation.ID, "questions", err, "Soru güncellendi.", "Katılım soruları güncellenemedi")
+}
+
+func (h *PanelRSVPQuestionHandler) MoveQuestion(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	questionID, _ := c.ParamsInt("questionID")
+	req := c.Locals("rsvpQuestionMoveRequest").(requests.RSVPQuestionMoveRequest)
+	err = h.questionService.MoveQuestion(c.UserContext(), invitation.ID, uint(questionID), req.Direction)
+	return redirectToInvitationTab(c, invitation.ID, "questions", err, "Soru sırası güncellendi.", "Katılım soruları güncellenemedi")
+}
+
+func (h *PanelRSVPQuestionHandler) DeleteQuestion(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	questionID, _ := c.ParamsInt("questionID")
+	err = h.questionService.DeleteQuestion(c.UserContext(), invitation.ID, uint(questionID))
+	return redirectToInvitationTab(c, invitation.ID, "questions", err, "Soru silindi.", "Katılım soruları güncellenemedi")
+}
+
+func rsvpQuestionFromRequest(c *fiber.Ctx) *models.RSVPQuestion {
+	req := c.Locals("rsvpQuestionRequest").(requests.RSVPQuestionRequest)
+	return &models.RSVPQuestion{
+		Label:    req.Label,
+		Type:     models.RSVPQuestionType(req.Type),
+		Options:  req.Options,
+		Required: req.Required,
+	}
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/renderer"
+	"davet.link/requests"
+	"davet.link/services"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+type PanelSeatingHandler struct {
+	invitationService services.IInvitationService
+	seatingService    services.ISeatingService
+}
+
+func NewPanelSeatingHandler() *PanelSeatingHandler {
+	return &PanelSeatingHandler{
+		invitationService: services.NewInvitationService(),
+		seatingService:    services.NewSeatingService(),
+	}
+}
+
+// Oturma planı ve masa atamaları (panel)
+func (h *PanelSeatingHandler) ShowSeating(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	plan, err := h.seatingService.GetSeatingPlan(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, err.Error()))
+	}
+	return renderer.Render(c, "panel/invitations/seating", "layouts/panel", fiber.Map{
+		"Title":      "Oturma Planı",
+		"Invitation": invitation,
+		"Plan":       plan,
+		"NextTable":  i18n.Translate(c, "Masa %d", len(plan.Tables)+1),
+	}, http.StatusOK)
+}
+
+func (h *PanelSeatingHandler) CreateTable(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	table := seatingTableFromRequest(c)
+	table.InvitationID = invitation.ID
+	err = h.seatingService.CreateTable(c.UserContext(), table)
+	return redirectToInvitationTab(c, invitation.ID, "seating", err, "Masa eklendi.", "Oturma planı güncellenemedi")
+}
+
+func (h *PanelSeatingHandler) UpdateTable(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	tableID, _ := c.ParamsInt("tableID")
+	err = h.seatingService.UpdateTable(c.UserContext(), invitation.ID, uint(tableID), seatingTableFromRequest(c))
+	return redirectToInvitationTab(c, invitation.ID, "seating", err, "Masa güncellendi.", "Oturma planı güncellenemedi")
+}
+
+func (h *PanelSeatingHandler) DeleteTable(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	tableID, _ := c.ParamsInt("tableID")
+	err = h.seatingService.DeleteTable(c.UserContext(), invitation.ID, uint(tableID))
+	return redirectToInvitationTab(c, invitation.ID, "seating", err, "Masa silindi.", "Oturma planı güncellenemedi")
+}
+
+// Kapasiteyi aşan atamalar da kaydedilir; aşılan masalar uyarı olarak bildirilir
+func (h *PanelSeatingHandler) AssignSeats(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	participantID, _ := c.ParamsInt("participantID")
+	req := c.Locals("seatAssignmentRequest").(requests.SeatAssignmentRequest)
+	over, err := h.seatingService.AssignSeats(c.UserContext(), invitation.ID, uint(participantID), req.TableID, req.Companions())
+	if err == nil && len(over) > 0 {
+		names := make([]string, len(over))
+		for i, seating := range over {
+			names[i] = i18n.Translate(c, "%s (%d / %d kişi)", seating.Table.Name, seating.Seated, seating.Table.Capacity)
+		}
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey,
+			i18n.Translate(c, "Yerleşim kaydedildi, ancak masa kapasitesi aşıldı: %s", strings.Join(names, ", ")))
+		return c.Redirect(invitationTabPath(invitation.ID, "seating"), http.StatusSeeOther)
+	}
+	return redirectToInvitationTab(c, invitation.ID, "seating", err, "Yerleşim kaydedildi.", "Oturma planı güncellenemedi")
+}
+
+// Oturma planını yazdırılabilir PDF olarak indirme (panel)
+func (h *PanelSeatingHandler) PrintSeating(c *fiber.Ctx) error {
+	invitation, err := ownedInvitation(c, h.invitationService)
+	if err != nil {
+		return c.Status(http.StatusNotFound).SendString(i18n.Translate(c, "Davetiye bulunamadı"))
+	}
+	plan, err := h.seatingService.GetSeatingPlan(invitation.ID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, err.Error()))
+	}
+	var buf bytes.Buffer
+	if err := h.seatingService.WritePDF(&buf, invitation, plan, i18n.FromCtx(c)); err != nil {
+		logconfig.Log.Error("Oturma planı PDF olarak oluşturulamadı", zap.Uint("invitation_id", invitation.ID), zap.Error(err))
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "Oturma planı dışa aktarılamadı"))
+	}
+	c.Set(fiber.HeaderContentType, "application/pdf")
+	c.Set(fiber.HeaderContentDisposition, "inline; filename="+strconv.Quote("oturma-plani-"+invitation.InvitationKey+".pdf"))
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Status(http.StatusOK).Send(buf.Bytes())
+}
+
+func seatingTableFromRequest(c *fiber.Ctx) *models.SeatingTable {
+	req := c.Locals("seatingTableRequest").(requests.SeatingTableRequest)
+	return &models.SeatingTable{
+		Name:     req.Name,
+		Capacity: req.Capacity,
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"davet.link/models"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/renderer"
+	"davet.link/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Panelde seçilebilen istatistik dönemleri (gün)
+var statsPeriods = []int{7, 30, 90}
+
+// renderPageViewStats, davetiye ve kartvizit istatistik sayfalarını ortak görünümle çizer.
+func renderPageViewStats(c *fiber.Ctx, analyticsService services.IAnalyticsService, target models.PageViewTarget, targetID uint, title, name, publicURL string) error {
+	stats, err := analyticsService.GetPageViewStats(target, targetID, c.QueryInt("days", services.AnalyticsDefaultDays))
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).SendString(i18n.Translate(c, "İstatistikler getirilemedi"))
+	}
+
+	labels := make([]string, 0, len(stats.Daily))
+	views := make([]int64, 0, len(stats.Daily))
+	visitors := make([]int64, 0, len(stats.Daily))
+	for _, day := range stats.Daily {
+		labels = append(labels, day.Day.Format("02.01"))
+		views = append(views, day.Views)
+		visitors = append(visitors, day.Visitors)
+	}
+
+	return renderer.Render(c, "panel/stats", "layouts/panel", fiber.Map{
+		"Title":         title,
+		"Name":          name,
+		"PublicURL":     publicURL,
+		"Stats":         stats,
+		"Periods":       statsPeriods,
+		"ChartLabels":   labels,
+		"ChartViews":    views,
+		"ChartVisitors": visitors,
+	}, http.StatusOK)
+}
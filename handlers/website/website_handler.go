@@ -1,18 +1,71 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	"davet.link/configs/envconfig"
+	"davet.link/models"
+	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/htmlsanitizer"
+	"davet.link/pkg/i18n"
 	"davet.link/pkg/renderer"
+	"davet.link/pkg/themes"
+	"davet.link/pkg/vcard"
+	"davet.link/requests"
+	"davet.link/services"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// pageMeta, og:/twitter: etiketlerinde kullanılan sayfa bilgileridir
+type pageMeta struct {
+	Title       string
+	Description string
+	Image       string
+	URL         string
+	// og:type; boşsa "website"
+	Type string
+}
+
 type WebsiteHandler struct {
+	invitationService services.IInvitationService
+	cardService       services.ICardService
+	calendarService   services.ICalendarService
+	ogImageService    services.IOGImageService
+	sitemapService    services.ISitemapService
+	analyticsService  services.IAnalyticsService
+	slugService       services.ISlugService
+	themeService      services.IThemeService
+	guestbookService  services.IGuestbookService
+	mediaService      services.IInvitationMediaService
+	eventService      services.IInvitationEventService
+	guestService      services.IGuestService
+	questionService   services.IRSVPQuestionService
+	seatingService    services.ISeatingService
 }
 
 func NewWebsiteHandler() *WebsiteHandler {
-	return &WebsiteHandler{}
+	return &WebsiteHandler{
+		invitationService: services.NewInvitationService(),
+		cardService:       services.NewCardService(),
+		calendarService:   services.NewCalendarService(),
+		ogImageService:    services.NewOGImageService(),
+		sitemapService:    services.NewSitemapService(),
+		analyticsService:  services.NewAnalyticsService(),
+		slugService:       services.NewSlugService(),
+		themeService:      services.NewThemeService(),
+		guestbookService:  services.NewGuestbookService(),
+		mediaService:      services.NewInvitationMediaService(),
+		eventService:      services.NewInvitationEventService(),
+		guestService:      services.NewGuestService(),
+		questionService:   services.NewRSVPQuestionService(),
+		seatingService:    services.NewSeatingService(),
+	}
 }
 
 func (h *WebsiteHandler) ShowHomePage(c *fiber.Ctx) error {
@@ -24,20 +77,442 @@ func (h *WebsiteHandler) ShowTermsOfUse(c *fiber.Ctx) error {
 	return renderer.Render(c, "website/terms_of_use", "layouts/website", fiber.Map{}, http.StatusOK)
 }
 
-func (h *WebsiteHandler) ShowStaticPage(c *fiber.Ctx) error {
-	page := c.Params("staticPageName")
-	template := "website/" + page
-	return renderer.Render(c, template, "layouts/website", fiber.Map{}, http.StatusOK)
+// ShowSlug, /<adres> isteklerini sayfa, davetiye veya /@kartvizit olarak çözer.
+func (h *WebsiteHandler) ShowSlug(c *fiber.Ctx) error {
+	resolved, err := h.slugService.Resolve(c.Params("slug"))
+	if err != nil {
+		if errors.Is(err, services.ErrSlugNotFound) {
+			return renderNotFound(c)
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	switch resolved.Kind {
+	case services.SlugKindCard:
+		return h.renderCard(c, resolved.Card)
+	case services.SlugKindInvitation:
+		return h.renderInvitation(c, resolved.Invitation)
+	}
+	if resolved.Page == nil {
+		return renderer.Render(c, resolved.Template, "layouts/website", fiber.Map{}, http.StatusOK)
+	}
+	return h.renderPage(c, resolved.Page)
+}
+
+func (h *WebsiteHandler) renderPage(c *fiber.Ctx, page *models.Page) error {
+	metaTitle := page.MetaTitle
+	if metaTitle == "" {
+		metaTitle = page.Title
+	}
+	return renderer.Render(c, "website/page", "layouts/website", fiber.Map{
+		"Title": page.Title,
+		"Page":  page,
+		"Body":  htmlsanitizer.SafeHTML(page.Body),
+		"Meta": pageMeta{
+			Title:       metaTitle,
+			Description: page.MetaDescription,
+			URL:         envconfig.GetBaseURL() + "/" + page.Slug,
+		},
+	}, http.StatusOK)
+}
+
+func (h *WebsiteHandler) renderInvitation(c *fiber.Ctx, source *models.Invitation) error {
+	h.recordPageView(c, models.PageViewInvitation, source.ID)
+
+	invitation, detail := services.LocalizeInvitation(source, i18n.FromCtx(c))
+	if detail == nil {
+		detail = &models.InvitationDetail{}
+	}
+
+	metaTitle := invitation.Title
+	if metaTitle == "" {
+		metaTitle = detail.Title
+	}
+	baseURL := envconfig.GetBaseURL()
+	data := fiber.Map{
+		"Title":      invitation.Title,
+		"Invitation": invitation,
+		"Detail":     detail,
+		"Theme":      h.themeService.GetInvitationTheme(source),
+		"Meta": pageMeta{
+			Title:       metaTitle,
+			Description: services.InvitationMetaDescription(invitation),
+			Image:       versionedURL(baseURL+"/og/invitation/"+invitation.InvitationKey+".png", services.InvitationModifiedAt(invitation)),
+			URL:         baseURL + "/" + invitation.InvitationKey,
+		},
+	}
+	// Defter okunamazsa davetiye mesajlar olmadan gösterilir
+	if entries, err := h.guestbookService.GetApprovedEntries(source.ID); err == nil {
+		data["Guestbook"] = entries
+	}
+	if media, err := h.mediaService.GetMedia(source.ID); err == nil && len(media) > 0 {
+		data["Gallery"] = media
+	}
+	if events, err := h.eventService.GetEvents(source.ID); err == nil && len(events) > 0 {
+		data["Programme"] = events
+	}
+	if source.IsParticipant {
+		if questions, err := h.questionService.GetQuestions(source.ID); err == nil && len(questions) > 0 {
+			data["Questions"] = questions
+		}
+		if availability, err := h.invitationService.GetRSVPAvailability(source); err == nil {
+			data["RSVP"] = availability
+		}
+	}
+	// Kişiye özel bağlantıyla (?g=) gelen davetli adıyla karşılanır; geçersiz anahtarda sayfa herkese açık haliyle gösterilir.
+	// Bağlantı sohbette paylaşıldığında yapılan önizlemeler davetliyi "açtı" olarak işaretlemez.
+	if token := c.Query("g"); token != "" {
+		openGuestLink := h.guestService.GetGuestByLink
+		if isVisit(c) {
+			openGuestLink = h.guestService.OpenGuestLink
+		}
+		if guest, err := openGuestLink(source.ID, token); err == nil {
+			data["Guest"] = guest
+			// Katılacağını bildiren davetliye oturma planındaki masası gösterilir
+			if guest.Participant != nil {
+				if seats, err := h.seatingService.GetGuestSeats(guest.Participant); err == nil && len(seats) > 0 {
+					data["Seats"] = seats
+				}
+			}
+		}
+	}
+	// Çevirisi olan davetiyelerde dil seçicide yalnızca içeriğin sunulduğu diller gösterilir
+	if len(source.Translations) > 0 {
+		data["Locales"] = i18n.Options(services.InvitationContentLocales(source)...)
+	}
+	view := themes.CategoryView(services.InvitationCategoryTemplate(invitation))
+	return renderer.Render(c, view, "layouts/invitation", data, http.StatusOK)
+}
+
+func (h *WebsiteHandler) SubmitGuestbookEntry(c *fiber.Ctx) error {
+	invitationKey := c.Params("invitationKey")
+	redirectPath := "/" + invitationKey
+
+	req, ok := c.Locals("guestbookRequest").(requests.GuestbookRequest)
+	if !ok {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Geçersiz istek formatı")
+		return c.Redirect(redirectPath, fiber.StatusSeeOther)
+	}
+	// Tuzak alanı dolduran botlara başarılı yanıt verilir; mesaj kaydedilmez
+	if req.Website != "" {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Mesajınız alındı, onaylandıktan sonra yayınlanacak.")
+		return c.Redirect(redirectPath, fiber.StatusSeeOther)
+	}
+
+	entry := &models.GuestbookEntry{
+		Name:    req.Name,
+		Message: req.Message,
+	}
+	if err := h.guestbookService.SubmitEntry(invitationKey, entry); err != nil {
+		if errors.Is(err, services.ErrInvitationNotFound) {
+			return renderNotFound(c)
+		}
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Mesajınız kaydedilemedi. Lütfen tekrar deneyin.")
+		return c.Redirect(redirectPath, fiber.StatusSeeOther)
+	}
+	_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Mesajınız alındı, onaylandıktan sonra yayınlanacak.")
+	return c.Redirect(redirectPath, fiber.StatusSeeOther)
+}
+
+// rsvpAnswerMessage, kabul edilmeyen soru yanıtı için sorunun adını içeren hata mesajını davetlinin dilinde döndürür.
+func rsvpAnswerMessage(c *fiber.Ctx, answerErr *services.RSVPAnswerError) string {
+	switch {
+	case errors.Is(answerErr, services.ErrRSVPAnswerRequired):
+		return i18n.Translate(c, "Lütfen «%s» sorusunu yanıtlayın", answerErr.Question)
+	case errors.Is(answerErr, services.ErrRSVPAnswerTooLong):
+		return i18n.Translate(c, "«%s» sorusunun yanıtı en fazla 500 karakter olabilir", answerErr.Question)
+	case errors.Is(answerErr, services.ErrRSVPAnswerNumber):
+		return i18n.Translate(c, "«%s» sorusunun yanıtı 0 ile 999999 arasında bir sayı olmalıdır", answerErr.Question)
+	default:
+		return i18n.Translate(c, "«%s» sorusuna geçerli bir yanıt verin", answerErr.Question)
+	}
+}
+
+func (h *WebsiteHandler) GuestbookLimitReached(c *fiber.Ctx) error {
+	_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Çok fazla mesaj gönderdiniz. Lütfen biraz sonra tekrar deneyin.")
+	return c.Redirect("/"+c.Params("invitationKey"), fiber.StatusSeeOther)
 }
 
-func (h *WebsiteHandler) ShowInvitation(c *fiber.Ctx) error {
+func (h *WebsiteHandler) SubmitRSVP(c *fiber.Ctx) error {
 	invitationKey := c.Params("invitationKey")
-	// TODO: Davetiye verisini çek ve render et
-	return renderer.Render(c, "website/invitation", "layouts/website", fiber.Map{"InvitationKey": invitationKey}, http.StatusOK)
+	redirectPath := "/" + invitationKey
+
+	req, ok := c.Locals("rsvpRequest").(requests.RSVPRequest)
+	if !ok {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Geçersiz istek formatı")
+		return c.Redirect(redirectPath, fiber.StatusSeeOther)
+	}
+	if req.GuestToken != "" {
+		redirectPath += "?g=" + url.QueryEscape(req.GuestToken)
+	}
+
+	participant := &models.InvitationParticipant{
+		Title:       strings.TrimSpace(req.Title),
+		PhoneNumber: req.PhoneNumber,
+		GuestCount:  req.GuestCount,
+		Status:      models.RSVPStatus(req.Status),
+		Companions:  req.Companions(),
+	}
+	answers := make(map[uint][]string)
+	c.Request().PostArgs().VisitAll(func(key, value []byte) {
+		if questionID, ok := services.ParseRSVPAnswerField(string(key)); ok {
+			answers[questionID] = append(answers[questionID], string(value))
+		}
+	})
+	created, err := h.invitationService.SubmitRSVP(invitationKey, participant, answers)
+	if err != nil {
+		var answerErr *services.RSVPAnswerError
+		switch {
+		case errors.As(err, &answerErr):
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, rsvpAnswerMessage(c, answerErr))
+		case errors.Is(err, services.ErrInvitationNotFound):
+			return renderNotFound(c)
+		case errors.Is(err, services.ErrParticipationClosed):
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Bu davetiye için katılım bildirimi kapalıdır.")
+		case errors.Is(err, services.ErrRSVPDeadlinePassed):
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Katılım bildirimi için son tarih geçti.")
+		case errors.Is(err, services.ErrRSVPCapacity):
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Kontenjan dolu olduğu için kişi sayısını artıramıyoruz. Daha önceki bildiriminiz geçerlidir.")
+		case errors.Is(err, services.ErrRSVPCompanionLimit):
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Kişi sayısı davet sahibinin belirlediği sınırı aşıyor.")
+		case errors.Is(err, services.ErrCompanionCount), errors.Is(err, services.ErrCompanionName), errors.Is(err, services.ErrCompanionDiet):
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, i18n.Translate(c, "Lütfen yanınızda gelecek kişilerin bilgilerini kontrol edin")+": "+i18n.Translate(c, err.Error()))
+		case errors.Is(err, services.ErrInvalidPhoneNumber):
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Lütfen geçerli bir telefon numarası giriniz.")
+		case errors.Is(err, services.ErrInvalidRSVPStatus):
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Lütfen katılım durumunuzu seçin")
+		case errors.Is(err, services.ErrRSVPGuestCount):
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Kişi sayısı en az 1 olmalıdır")
+		default:
+			_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, "Katılım bildiriminiz kaydedilemedi. Lütfen tekrar deneyin.")
+		}
+		return c.Redirect(redirectPath, fiber.StatusSeeOther)
+	}
+	// Eşleştirme başarısız olsa da bildirim kaydedildiğinden davetliye hata gösterilmez
+	if req.GuestToken != "" {
+		_ = h.guestService.LinkParticipant(participant.InvitationID, req.GuestToken, participant.ID)
+	}
+
+	switch {
+	case participant.Status == models.RSVPDeclined:
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Yanıtınız alındı. Haber verdiğiniz için teşekkür ederiz.")
+	case participant.Status == models.RSVPWaitlisted:
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Kontenjan dolu olduğu için bekleme listesine alındınız. Yer açıldığında katılımınız onaylanacak.")
+	case created:
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Katılım bildiriminiz alındı. Teşekkür ederiz!")
+	default:
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashSuccessKey, "Katılım bildiriminiz güncellendi.")
+	}
+	return c.Redirect(redirectPath, fiber.StatusSeeOther)
+}
+
+func (h *WebsiteHandler) DownloadInvitationCalendar(c *fiber.Ctx) error {
+	invitationKey := c.Params("invitationKey")
+	data, err := h.calendarService.GetInvitationCalendar(invitationKey)
+	if err != nil {
+		if errors.Is(err, services.ErrInvitationNotFound) || errors.Is(err, services.ErrInvitationDateEmpty) {
+			return renderNotFound(c)
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return sendCalendar(c, invitationKey+".ics", data)
+}
+
+func (h *WebsiteHandler) DownloadUserCalendar(c *fiber.Ctx) error {
+	data, err := h.calendarService.GetUserCalendarFeed(c.Params("calendarToken"))
+	if err != nil {
+		if errors.Is(err, services.ErrCalendarNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return sendCalendar(c, "davet.link.ics", data)
+}
+
+func (h *WebsiteHandler) renderCard(c *fiber.Ctx, card *models.Card) error {
+	h.recordPageView(c, models.PageViewCard, card.ID)
+
+	baseURL := envconfig.GetBaseURL()
+	title := card.Name
+	if title == "" {
+		title = "@" + card.Slug
+	}
+	description := title
+	if card.Title != "" {
+		description += " - " + card.Title
+	}
+	return renderer.Render(c, "website/card", "layouts/website", fiber.Map{
+		"Title":    card.Name,
+		"Card":     card,
+		"VCardURL": "/@" + card.Slug + ".vcf",
+		"Meta": pageMeta{
+			Title:       title,
+			Description: description,
+			Image:       versionedURL(baseURL+"/og/card/"+card.Slug+".png", card.UpdatedAt),
+			URL:         baseURL + "/@" + card.Slug,
+			Type:        "profile",
+		},
+	}, http.StatusOK)
+}
+
+func (h *WebsiteHandler) DownloadCardVCard(c *fiber.Ctx) error {
+	card, err := h.getPublicCard(c)
+	if err != nil {
+		return err
+	}
+	if card == nil {
+		return renderNotFound(c)
+	}
+
+	profiles := make([]vcard.SocialProfile, 0, len(card.CardSocialMedia))
+	for _, social := range card.CardSocialMedia {
+		profiles = append(profiles, vcard.SocialProfile{
+			Type: social.SocialMedia.Name,
+			URL:  social.URL,
+		})
+	}
+
+	baseURL := envconfig.GetBaseURL()
+	fullName := card.Name
+	if fullName == "" {
+		fullName = card.Slug
+	}
+	data := vcard.Card{
+		UID:       baseURL + "/@" + card.Slug,
+		FullName:  fullName,
+		Title:     card.Title,
+		PhotoURL:  absoluteURL(baseURL, card.Photo),
+		Telephone: card.Telephone,
+		Email:     card.Email,
+		Website:   card.Website,
+		Location:  card.Location,
+		SourceURL: baseURL + "/@" + card.Slug + ".vcf",
+		Social:    profiles,
+		Revision:  card.UpdatedAt,
+	}.Encode()
+
+	c.Set(fiber.HeaderContentType, "text/vcard; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, "attachment; filename="+strconv.Quote(card.Slug+".vcf"))
+	return c.Status(http.StatusOK).Send(data)
+}
+
+func (h *WebsiteHandler) ShowInvitationImage(c *fiber.Ctx) error {
+	path, err := h.ogImageService.GetInvitationImagePath(c.Params("invitationKey"))
+	if err != nil {
+		if errors.Is(err, services.ErrInvitationNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return sendOGImage(c, path)
+}
+
+func (h *WebsiteHandler) ShowCardImage(c *fiber.Ctx) error {
+	path, err := h.ogImageService.GetCardImagePath(c.Params("cardSlug"))
+	if err != nil {
+		if errors.Is(err, services.ErrCardNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return sendOGImage(c, path)
+}
+
+func (h *WebsiteHandler) ShowSitemap(c *fiber.Ctx) error {
+	data, err := h.sitemapService.GetSitemap()
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return sendXML(c, data)
+}
+
+func (h *WebsiteHandler) ShowSitemapPage(c *fiber.Ctx) error {
+	page, err := strconv.Atoi(c.Params("page"))
+	if err != nil {
+		return c.SendStatus(fiber.StatusNotFound)
+	}
+	data, err := h.sitemapService.GetSitemapPage(c.Params("section"), page)
+	if err != nil {
+		if errors.Is(err, services.ErrSitemapNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return sendXML(c, data)
+}
+
+func (h *WebsiteHandler) ShowRobots(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+	return c.Status(http.StatusOK).Send(h.sitemapService.GetRobots())
+}
+
+func (h *WebsiteHandler) recordPageView(c *fiber.Ctx, target models.PageViewTarget, targetID uint) {
+	if !isVisit(c) {
+		return
+	}
+	h.analyticsService.RecordPageView(services.PageViewInput{
+		Target:    target,
+		TargetID:  targetID,
+		IP:        c.IP(),
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+		Referrer:  c.Get(fiber.HeaderReferer),
+	})
+}
+
+// isVisit, isteğin sayfayı gerçekten açan bir ziyaretçiden gelip gelmediğini döndürür.
+// HEAD istekleri, tarayıcı ön yüklemeleri, tarayıcı olmayan istemciler ve bağlantı önizlemeleri ziyaret sayılmaz.
+func isVisit(c *fiber.Ctx) bool {
+	if c.Method() != fiber.MethodGet || c.Get("Sec-Purpose") != "" || c.Get("Purpose") == "prefetch" {
+		return false
+	}
+	return !services.IsBotUserAgent(c.Get(fiber.HeaderUserAgent))
+}
+
+// Kartvizit bulunamazsa (nil, nil) döner
+func (h *WebsiteHandler) getPublicCard(c *fiber.Ctx) (*models.Card, error) {
+	card, err := h.cardService.GetPublicCardBySlug(c.Params("cardSlug"))
+	if err != nil {
+		if errors.Is(err, services.ErrCardNotFound) {
+			return nil, nil
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return card, nil
+}
+
+func sendCalendar(c *fiber.Ctx, filename string, data []byte) error {
+	c.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, "inline; filename="+strconv.Quote(filename))
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	return c.Status(http.StatusOK).Send(data)
+}
+
+// Dosya adı kayıt güncellendikçe değiştiğinden ?v= ile sürümlenen adres uzun süre önbelleğe alınabilir
+func sendOGImage(c *fiber.Ctx, path string) error {
+	c.Set(fiber.HeaderContentType, "image/png")
+	c.Set(fiber.HeaderCacheControl, "public, max-age=3600")
+	return c.SendFile(path)
+}
+
+func sendXML(c *fiber.Ctx, data []byte) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationXMLCharsetUTF8)
+	c.Set(fiber.HeaderCacheControl, "public, max-age=900")
+	return c.Status(http.StatusOK).Send(data)
+}
+
+func versionedURL(url string, modifiedAt time.Time) string {
+	return url + "?v=" + strconv.FormatInt(modifiedAt.Unix(), 10)
+}
+
+func absoluteURL(baseURL, path string) string {
+	if path == "" || strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+	return baseURL + "/" + strings.TrimLeft(path, "/")
 }
 
-func (h *WebsiteHandler) ShowCard(c *fiber.Ctx) error {
-	cardSlug := c.Params("cardSlug")
-	// TODO: Kartvizit verisini çek ve render et
-	return renderer.Render(c, "website/card", "layouts/website", fiber.Map{"CardSlug": cardSlug}, http.StatusOK)
+func renderNotFound(c *fiber.Ctx) error {
+	return renderer.Render(c, "website/not_found", "layouts/website", fiber.Map{
+		"Title": i18n.Translate(c, "Sayfa Bulunamadı"),
+	}, http.StatusNotFound)
 }
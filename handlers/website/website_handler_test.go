@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestIsVisit(t *testing.T) {
+	const browser = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1"
+	tests := []struct {
+		name    string
+		method  string
+		headers map[string]string
+		want    bool
+	}{
+		{"tarayıcı", fiber.MethodGet, map[string]string{"User-Agent": browser}, true},
+		{"HEAD", fiber.MethodHead, map[string]string{"User-Agent": browser}, false},
+		{"Sec-Purpose ön yüklemesi", fiber.MethodGet, map[string]string{"User-Agent": browser, "Sec-Purpose": "prefetch"}, false},
+		{"Purpose ön yüklemesi", fiber.MethodGet, map[string]string{"User-Agent": browser, "Purpose": "prefetch"}, false},
+		{"WhatsApp önizlemesi", fiber.MethodGet, map[string]string{"User-Agent": "WhatsApp/2.23.20.0"}, false},
+		{"Telegram önizlemesi", fiber.MethodGet, map[string]string{"User-Agent": "TelegramBot (like TwitterBot)"}, false},
+		{"Facebook önizlemesi", fiber.MethodGet, map[string]string{"User-Agent": "facebookexternalhit/1.1"}, false},
+		{"arama motoru", fiber.MethodGet, map[string]string{"User-Agent": "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"}, false},
+		{"User-Agent yok", fiber.MethodGet, map[string]string{"User-Agent": ""}, false},
+	}
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		// HEAD yanıtında gövde olmadığından sonuç başlıkla döndürülür
+		c.Set("X-Visit", strconv.FormatBool(isVisit(c)))
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, "/", nil)
+		for key, value := range tt.headers {
+			req.Header.Set(key, value)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got := resp.Header.Get("X-Visit") == "true"; got != tt.want {
+			t.Errorf("%s: isVisit = %v, %v bekleniyordu", tt.name, got, tt.want)
+		}
+	}
+}
@@ -3,11 +3,14 @@ package middlewares
 import (
 	"context"
 
+	"davet.link/configs/logconfig"
 	"davet.link/configs/sessionconfig"
 	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/i18n"
 	"davet.link/services"
 
 	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
 )
 
 func AuthMiddleware(c *fiber.Ctx) error {
@@ -30,6 +33,13 @@ func AuthMiddleware(c *fiber.Ctx) error {
 	ctx = context.WithValue(ctx, "user_email", user.Email)
 	c.SetUserContext(ctx)
 
+	// Oturumdayken ?lang ile seçilen dil kullanıcının tercihi olarak saklanır
+	if locale := i18n.Normalize(c.Query(i18n.QueryParam)); locale != "" && locale != user.Locale {
+		if err := authService.UpdateLocale(ctx, userID, locale); err != nil {
+			logconfig.Log.Warn("Dil tercihi kaydedilemedi", zap.Uint("user_id", userID), zap.Error(err))
+		}
+	}
+
 	c.Locals("userID", userID)
 	c.Locals("userType", user.Type)
 	c.Locals("userEmail", user.Email)
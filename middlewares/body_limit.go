@@ -0,0 +1,53 @@
+package middlewares
+
+import (
+	"io"
+
+	"davet.link/configs/envconfig"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// BodyLimitMiddleware, istek gövdesini Fiber'in varsayılan sınırıyla (4 MB) kısıtlar; aşan isteklere 413 döner.
+// Sunucu gövdeyi akış olarak aldığından (StreamRequestBody) sınır Fiber yerine burada uygulanır; bu nedenle
+// gövdeyi okuyan ara katmanlardan (CSRF) önce eklenmelidir.
+// upload true dönen isteklerde sınır APP_UPLOAD_LIMIT_MB'dir (varsayılan 50 MB). Yüklenen dosyalar belleğe
+// alınmadan geçici dosyalara akıtıldığından bu isteklerde uzunluğu bildirilmeyen gövde kabul edilmez.
+func BodyLimitMiddleware(upload func(c *fiber.Ctx) bool) fiber.Handler {
+	uploadLimit := envconfig.GetEnvAsInt("APP_UPLOAD_LIMIT_MB", 50) * 1024 * 1024
+	return func(c *fiber.Ctx) error {
+		req := c.Request()
+		length := req.Header.ContentLength()
+		if upload != nil && upload(c) {
+			if length < 0 {
+				return reject(c, fiber.ErrLengthRequired)
+			}
+			if length > uploadLimit {
+				return reject(c, fiber.ErrRequestEntityTooLarge)
+			}
+			return c.Next()
+		}
+
+		if length > fiber.DefaultBodyLimit {
+			return reject(c, fiber.ErrRequestEntityTooLarge)
+		}
+		// Uzunluğu bildirilmeyen (chunked) gövdeden sınırın en fazla bir bayt fazlası okunur
+		if length < 0 && req.IsBodyStream() {
+			body, err := io.ReadAll(io.LimitReader(req.BodyStream(), fiber.DefaultBodyLimit+1))
+			if err != nil {
+				return fiber.ErrBadRequest
+			}
+			if len(body) > fiber.DefaultBodyLimit {
+				return reject(c, fiber.ErrRequestEntityTooLarge)
+			}
+			req.SetBody(body)
+		}
+		return c.Next()
+	}
+}
+
+// reject, okunmayan gövdenin sonraki istek sanılmaması için yanıttan sonra bağlantıyı kapatır.
+func reject(c *fiber.Ctx, err error) error {
+	c.Context().SetConnectionClose()
+	return err
+}
@@ -0,0 +1,122 @@
+package middlewares
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// newBodyLimitApp, main.go'daki gibi gövdeyi akış olarak alan bir uygulama kurar; /upload yükleme rotası sayılır.
+func newBodyLimitApp() *fiber.App {
+	app := fiber.New(fiber.Config{StreamRequestBody: true, DisablePreParseMultipartForm: true})
+	app.Use(BodyLimitMiddleware(func(c *fiber.Ctx) bool { return c.Path() == "/upload" }))
+	app.Post("/form", func(c *fiber.Ctx) error {
+		return c.SendString(strconv.Itoa(len(c.FormValue("message"))))
+	})
+	app.Post("/upload", func(c *fiber.Ctx) error {
+		file, err := c.FormFile("photo")
+		if err != nil {
+			return err
+		}
+		return c.SendString(strconv.FormatInt(file.Size, 10))
+	})
+	return app
+}
+
+// newRequest, gövdeyi chunked true ise Transfer-Encoding: chunked ile, uzunluğunu bildirmeden gönderir.
+func newRequest(path, contentType string, body io.Reader, chunked bool) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, path, body)
+	req.Header.Set("Content-Type", contentType)
+	if chunked {
+		req.ContentLength = -1
+		req.TransferEncoding = []string{"chunked"}
+	}
+	return req
+}
+
+func multipartBody(t *testing.T, size int) (*bytes.Buffer, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, err := writer.CreateFormFile("photo", "foto.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := part.Write(bytes.Repeat([]byte{0xFF}, size)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf, writer.FormDataContentType()
+}
+
+func TestBodyLimitMiddleware(t *testing.T) {
+	small := "message=" + strings.Repeat("a", 1000)
+	large := "message=" + strings.Repeat("a", fiber.DefaultBodyLimit)
+	tests := []struct {
+		name    string
+		body    string
+		chunked bool
+		status  int
+		want    string
+	}{
+		{"küçük form", small, false, http.StatusOK, "1000"},
+		{"küçük chunked form", small, true, http.StatusOK, "1000"},
+		{"4 MB'yi aşan form", large, false, http.StatusRequestEntityTooLarge, ""},
+		{"4 MB'yi aşan chunked form", large, true, http.StatusRequestEntityTooLarge, ""},
+	}
+	app := newBodyLimitApp()
+	for _, tt := range tests {
+		req := newRequest("/form", "application/x-www-form-urlencoded", strings.NewReader(tt.body), tt.chunked)
+		resp, err := app.Test(req, -1)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != tt.status || (tt.want != "" && string(body) != tt.want) {
+			t.Errorf("%s: %d %q, %d %q bekleniyordu", tt.name, resp.StatusCode, body, tt.status, tt.want)
+		}
+	}
+}
+
+func TestBodyLimitMiddlewareUpload(t *testing.T) {
+	app := newBodyLimitApp()
+	size := fiber.DefaultBodyLimit + 1<<20
+
+	body, contentType := multipartBody(t, size)
+	resp, err := app.Test(newRequest("/upload", contentType, body, false), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(got) != strconv.Itoa(size) {
+		t.Errorf("yükleme: %d %q, 200 %q bekleniyordu", resp.StatusCode, got, strconv.Itoa(size))
+	}
+
+	// Aynı gövde yükleme rotası dışında kabul edilmez
+	body, contentType = multipartBody(t, size)
+	if resp, err := app.Test(newRequest("/form", contentType, body, false), -1); err != nil || resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("yükleme rotası dışında büyük gövde: %v, 413 bekleniyordu", statusOf(resp, err))
+	}
+
+	// Yüklemede uzunluğu bildirilmeyen gövde reddedilir
+	body, contentType = multipartBody(t, 1000)
+	if resp, err := app.Test(newRequest("/upload", contentType, body, true), -1); err != nil || resp.StatusCode != http.StatusLengthRequired {
+		t.Errorf("chunked yükleme: %v, 411 bekleniyordu", statusOf(resp, err))
+	}
+}
+
+func statusOf(resp *http.Response, err error) interface{} {
+	if err != nil {
+		return err
+	}
+	return resp.StatusCode
+}
@@ -0,0 +1,36 @@
+package middlewares
+
+import (
+	"davet.link/configs/logconfig"
+	"davet.link/configs/sessionconfig"
+	"davet.link/pkg/i18n"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/session"
+	"go.uber.org/zap"
+)
+
+// LocaleMiddleware, isteğin dilini sırasıyla ?lang parametresi, oturumda saklanan seçim ve
+// Accept-Language başlığına göre belirler. ?lang ile seçilen dil oturuma yazılır.
+func LocaleMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		locale := i18n.Normalize(c.Query(i18n.QueryParam))
+		if locale != "" {
+			if err := sessionconfig.SetSessionValue(c, i18n.LocaleKey, locale); err != nil {
+				logconfig.Log.Warn("Dil seçimi oturuma kaydedilemedi", zap.String("locale", locale), zap.Error(err))
+			}
+		} else if sess, ok := c.Locals("session").(*session.Session); ok {
+			if saved, ok := sess.Get(i18n.LocaleKey).(string); ok {
+				locale = i18n.Normalize(saved)
+			}
+		}
+		if locale == "" {
+			locale = i18n.Match(c.Get(fiber.HeaderAcceptLanguage))
+			c.Vary(fiber.HeaderAcceptLanguage)
+		}
+
+		c.Locals(i18n.LocaleKey, locale)
+		c.Set(fiber.HeaderContentLanguage, locale)
+		return c.Next()
+	}
+}
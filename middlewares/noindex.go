@@ -0,0 +1,18 @@
+package middlewares
+
+import (
+	"davet.link/configs/envconfig"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NoIndexMiddleware, production dışındaki ortamların (staging vb.) arama motorlarında listelenmesini engeller.
+func NoIndexMiddleware() fiber.Handler {
+	production := envconfig.IsProduction()
+	return func(c *fiber.Ctx) error {
+		if !production {
+			c.Set("X-Robots-Tag", "noindex, nofollow")
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// GuestStatus, davetlinin bağlantıya verdiği tepkidir; veritabanında tutulmaz, alanlardan türetilir.
+type GuestStatus string
+
+const (
+	GuestNotOpened GuestStatus = "not_opened"
+	GuestOpened    GuestStatus = "opened"
+	GuestResponded GuestStatus = "responded"
+)
+
+// Guest, davetiye sahibinin kişiye özel bağlantı gönderdiği davetlidir.
+// Katılım bildirimi (InvitationParticipant) yapılana kadar yalnızca davet listesinde yer alır;
+// bildirim yapıldığında ParticipantID ile eşleştirilir.
+type Guest struct {
+	BaseModel
+	InvitationID  uint       `gorm:"not null;index"`
+	Name          string     `gorm:"size:255;not null"`
+	PhoneNumber   string     `gorm:"size:20"` // E.164 biçiminde; boş olabilir
+	Token         string     `gorm:"size:32;not null;uniqueIndex"`
+	OpenedAt      *time.Time // Bağlantının ilk açıldığı zaman
+	ParticipantID *uint      `gorm:"index"`
+
+	Invitation  *Invitation            `gorm:"foreignKey:InvitationID"`
+	Participant *InvitationParticipant `gorm:"foreignKey:ParticipantID"`
+}
+
+// Status, davetlinin katılım bildirimi yapıp yapmadığını veya bağlantıyı açıp açmadığını döndürür.
+func (g Guest) Status() GuestStatus {
+	switch {
+	case g.ParticipantID != nil:
+		return GuestResponded
+	case g.OpenedAt != nil:
+		return GuestOpened
+	}
+	return GuestNotOpened
+}
+
+// TableName returns the table name for the Guest model
+func (Guest) TableName() string {
+	return "guests"
+}
@@ -0,0 +1,26 @@
+package models
+
+type GuestbookStatus string
+
+const (
+	GuestbookPending  GuestbookStatus = "pending"
+	GuestbookApproved GuestbookStatus = "approved"
+	GuestbookHidden   GuestbookStatus = "hidden"
+)
+
+// GuestbookEntry, davetiye sayfasından bırakılan tebrik mesajıdır.
+// Davetiye sahibi onaylayana kadar sayfada gösterilmez.
+type GuestbookEntry struct {
+	BaseModel
+	InvitationID uint            `gorm:"not null;index:idx_guestbook_entries_invitation,priority:1"`
+	Status       GuestbookStatus `gorm:"size:20;not null;default:'pending';index:idx_guestbook_entries_invitation,priority:2"`
+	Name         string          `gorm:"size:100;not null"`
+	Message      string          `gorm:"type:text;not null"`
+
+	Invitation *Invitation `gorm:"foreignKey:InvitationID"`
+}
+
+// TableName returns the table name for the GuestbookEntry model
+func (GuestbookEntry) TableName() string {
+	return "guestbook_entries"
+}
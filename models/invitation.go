@@ -22,18 +22,26 @@ type Invitation struct {
 	Link          string    `gorm:"size:255"`             // External link if any
 	Telephone     string    `gorm:"size:20"`              // Contact number
 	Note          string    `gorm:"type:text"`            // Additional notes
-	Date          time.Time `gorm:"index"`                // Event date
-	Time          time.Time                               // Event time
+	StartsAt      time.Time  `gorm:"index"`              // Event start; zero when no date is set
+	EndsAt        *time.Time                             // Optional event end
+	AllDay        bool       `gorm:"default:false"`      // Date without a time of day
+	TimeZone      string     `gorm:"size:64;not null;default:'Europe/Istanbul'"` // IANA zone of the venue
+	PrimaryLocale string    `gorm:"size:5;not null;default:'tr'"` // Language of the fields above
+	MaxHeadcount  int        `gorm:"not null;default:0"` // RSVP seat limit in people; 0 means unlimited
+	RSVPDeadline  *time.Time                             // RSVPs are not accepted after this time
+	MaxCompanions int        `gorm:"not null;default:0"` // Companions a participant may bring; 0 means only GuestCount limits it
 	
 	// Status fields
 	IsConfirmed   bool      `gorm:"default:false;index"`  // Whether approved by admin
 	IsParticipant bool      `gorm:"default:true"`         // Whether participation is allowed
+	IsPublic      bool      `gorm:"default:false;index"`  // Whether listed in sitemap / indexable
 	
 	// Relationships
 	User               *User                   `gorm:"foreignKey:UserID"`
 	Category           *InvitationCategory     `gorm:"foreignKey:CategoryID"`
 	InvitationDetail   *InvitationDetail      `gorm:"foreignKey:InvitationID;references:ID"`
 	Participants       []InvitationParticipant `gorm:"foreignKey:InvitationID"`
+	Translations       []InvitationTranslation `gorm:"foreignKey:InvitationID"`
 }
 
 // TableName returns the table name for the Invitation model
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// InvitationEvent, davetiyenin programındaki bir bölümdür (ör: kına gecesi, nikah, düğün).
+// Her bölüm kendi saatine ve mekânına sahip olabilir.
+type InvitationEvent struct {
+	BaseModel
+	InvitationID uint       `gorm:"not null;index:idx_invitation_events_start,priority:1"`
+	Name         string     `gorm:"size:100;not null"`
+	StartsAt     time.Time  `gorm:"not null;index:idx_invitation_events_start,priority:2"`
+	EndsAt       *time.Time // Boşsa bitiş saati belirtilmemiştir
+	Venue        string     `gorm:"size:255"`
+	Address      string     `gorm:"size:255"`
+	Location     string     `gorm:"size:255"` // Google Maps bağlantısı veya koordinat
+
+	Invitation *Invitation `gorm:"foreignKey:InvitationID"`
+}
+
+// TableName returns the table name for the InvitationEvent model
+func (InvitationEvent) TableName() string {
+	return "invitation_events"
+}
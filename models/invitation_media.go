@@ -0,0 +1,21 @@
+package models
+
+// InvitationMedia, davetiye galerisindeki bir fotoğraftır.
+// Path ve ThumbnailPath, yüklenen dosyaların /uploads altındaki adresleridir.
+type InvitationMedia struct {
+	BaseModel
+	InvitationID  uint   `gorm:"not null;index:idx_invitation_media_order,priority:1"`
+	SortOrder     int    `gorm:"not null;default:0;index:idx_invitation_media_order,priority:2"`
+	Path          string `gorm:"size:255;not null"`
+	ThumbnailPath string `gorm:"size:255;not null"`
+	Caption       string `gorm:"size:255"`
+	Width         int
+	Height        int
+
+	Invitation *Invitation `gorm:"foreignKey:InvitationID"`
+}
+
+// TableName returns the table name for the InvitationMedia model
+func (InvitationMedia) TableName() string {
+	return "invitation_media"
+}
@@ -1,15 +1,68 @@
 package models
 
+import "time"
+
+// RSVPStatus, davetlinin katılım bildirimindeki yanıtıdır.
+type RSVPStatus string
+
+const (
+	RSVPAttending RSVPStatus = "attending"
+	RSVPDeclined  RSVPStatus = "declined"
+	RSVPMaybe     RSVPStatus = "maybe"
+	// Kontenjan dolduğunda katılacağını bildirenler bekleme listesine alınır; davetli bu yanıtı seçemez
+	RSVPWaitlisted RSVPStatus = "waitlisted"
+	// Yalnızca yanıt geçmişinde kullanılır; katılımcı panelden silindiğinde eklenir
+	RSVPRemoved RSVPStatus = "removed"
+)
+
 type InvitationParticipant struct {
 	BaseModel
-	Title        string    `gorm:"size:255;not null"`
-	PhoneNumber  string    `gorm:"size:20;not null"`
-	GuestCount   int       `gorm:"not null;default:1"`
-	InvitationID uint      `gorm:"index;not null"` // Foreign key for many-to-one relationship
+	Title        string     `gorm:"size:255;not null"`
+	PhoneNumber  string     `gorm:"size:20;not null"`
+	GuestCount   int        `gorm:"not null;default:1"` // Katılmayanlarda 0
+	Status       RSVPStatus `gorm:"size:16;not null;default:'attending';index"`
+	RespondedAt  time.Time  // Son yanıtın zamanı
+	WaitlistedAt *time.Time `gorm:"index"`          // Bekleme listesine alınma zamanı; yer açıldığında sıra buna göre belirlenir
+	TableID      *uint      `gorm:"index"`          // Oturma planındaki masa; adı bildirilmemiş refakatçiler de bu masada sayılır
+	InvitationID uint       `gorm:"index;not null"` // Foreign key for many-to-one relationship
 	Invitation   Invitation
+	Answers      []RSVPAnswer           `gorm:"foreignKey:ParticipantID"` // Katılım sorularına verilen yanıtlar
+	Companions   []ParticipantCompanion `gorm:"foreignKey:ParticipantID"` // Yanında gelecek kişiler, SortOrder sırasıyla
 }
 
 // TableName returns the table name for the InvitationParticipant model
 func (InvitationParticipant) TableName() string {
 	return "invitation_participants"
 }
+
+// Answer, verilen soruya yanıt verilmişse yanıtı döndürür.
+func (p InvitationParticipant) Answer(questionID uint) *RSVPAnswer {
+	for i := range p.Answers {
+		if p.Answers[i].QuestionID == questionID {
+			return &p.Answers[i]
+		}
+	}
+	return nil
+}
+
+// SeatedAt, katılımcının verilen masaya atanıp atanmadığını döndürür.
+func (p InvitationParticipant) SeatedAt(tableID uint) bool {
+	return p.TableID != nil && *p.TableID == tableID
+}
+
+// ParticipantResponse, katılım bildirimi her yapıldığında ya da yanıt değiştiğinde eklenen geçmiş kaydıdır;
+// panelden kişi sayısının değiştirilmesi ve katılımcının silinmesi de kaydedilir.
+// Kayıtlar hiç güncellenmez ve silinmez; katılımcı silinse de geçmiş korunur.
+type ParticipantResponse struct {
+	ID            uint       `gorm:"primarykey"`
+	InvitationID  uint       `gorm:"not null;index"`
+	ParticipantID uint       `gorm:"not null;index"`
+	Status        RSVPStatus `gorm:"size:16;not null"`
+	GuestCount    int        `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"not null"`
+}
+
+// TableName returns the table name for the ParticipantResponse model
+func (ParticipantResponse) TableName() string {
+	return "participant_responses"
+}
@@ -0,0 +1,27 @@
+package models
+
+// InvitationTranslation, davetiye içeriğinin birincil dil dışındaki bir dildeki karşılığıdır.
+// Boş bırakılan alanlarda birincil dildeki içerik gösterilir.
+type InvitationTranslation struct {
+	BaseModel
+	InvitationID uint   `gorm:"not null;uniqueIndex:idx_invitation_translations_locale,priority:1"`
+	Locale       string `gorm:"size:5;not null;uniqueIndex:idx_invitation_translations_locale,priority:2"`
+	Title        string `gorm:"size:255"`
+	Description  string `gorm:"type:text"`
+	Note         string `gorm:"type:text"`
+	Venue        string `gorm:"size:255"`
+	// InvitationDetail.Title karşılığı
+	DetailTitle string `gorm:"size:255"`
+
+	Invitation *Invitation `gorm:"foreignKey:InvitationID"`
+}
+
+// IsEmpty, çevirinin hiçbir alanının doldurulmadığını bildirir.
+func (t InvitationTranslation) IsEmpty() bool {
+	return t.Title == "" && t.Description == "" && t.Note == "" && t.Venue == "" && t.DetailTitle == ""
+}
+
+// TableName returns the table name for the InvitationTranslation model
+func (InvitationTranslation) TableName() string {
+	return "invitation_translations"
+}
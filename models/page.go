@@ -0,0 +1,17 @@
+package models
+
+// Page, yönetim panelinden düzenlenen statik içerik sayfasıdır (ör: /dijital_davetiye).
+type Page struct {
+	BaseModel
+	Slug            string `gorm:"size:100;not null;uniqueIndex"`
+	Title           string `gorm:"size:255;not null"`
+	Body            string `gorm:"type:text"` // HTML content allowed (sanitized on render)
+	MetaTitle       string `gorm:"size:255"`
+	MetaDescription string `gorm:"size:300"`
+	IsPublished     bool   `gorm:"default:false;index"`
+}
+
+// TableName returns the table name for the Page model
+func (Page) TableName() string {
+	return "pages"
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+type PageViewTarget string
+
+const (
+	PageViewInvitation PageViewTarget = "invitation"
+	PageViewCard       PageViewTarget = "card"
+)
+
+// PageView, herkese açık bir davetiye veya kartvizit sayfasının tek bir görüntülenmesidir.
+// Ham IP ve tarayıcı bilgisi tutulmaz; ziyaretçi yalnızca günlük tuzlanmış özetiyle ayırt edilir.
+type PageView struct {
+	ID           uint           `gorm:"primarykey"`
+	TargetType   PageViewTarget `gorm:"size:20;not null;index:idx_page_views_target,priority:1"`
+	TargetID     uint           `gorm:"not null;index:idx_page_views_target,priority:2"`
+	Day          time.Time      `gorm:"type:date;not null;index:idx_page_views_target,priority:3"`
+	VisitorHash  string         `gorm:"size:64;not null"`
+	ReferrerHost string         `gorm:"size:255"`
+	DeviceClass  string         `gorm:"size:20;not null"`
+	CreatedAt    time.Time
+}
+
+// TableName returns the table name for the PageView model
+func (PageView) TableName() string {
+	return "page_views"
+}
@@ -0,0 +1,37 @@
+package models
+
+// CompanionAgeGroup, refakatçinin yetişkin mi çocuk mu olduğunu belirtir.
+type CompanionAgeGroup string
+
+const (
+	CompanionAdult CompanionAgeGroup = "adult"
+	CompanionChild CompanionAgeGroup = "child"
+)
+
+// ParticipantCompanion, katılımcının yanında getireceğini bildirdiği kişidir (eş, çocuk, arkadaş).
+// Katılımcı kendisi dahil GuestCount kişiyle gelir; refakatçi sayısı en fazla GuestCount-1'dir.
+// Katılımcı bildirimini yenilediğinde refakatçiler baştan yazılır.
+type ParticipantCompanion struct {
+	ID            uint              `gorm:"primarykey"`
+	ParticipantID uint              `gorm:"not null;index"`
+	SortOrder     int               `gorm:"not null;default:0"`
+	Name          string            `gorm:"size:100;not null"`
+	AgeGroup      CompanionAgeGroup `gorm:"size:8;not null;default:'adult'"`
+	DietaryNote   string            `gorm:"size:255"` // Alerji, vejetaryen vb.
+	TableID       *uint             `gorm:"index"`    // Katılımcıdan ayrı bir masaya atandıysa; nil ise katılımcının masasında oturur
+}
+
+// TableName returns the table name for the ParticipantCompanion model
+func (ParticipantCompanion) TableName() string {
+	return "participant_companions"
+}
+
+// IsChild, refakatçinin çocuk olarak bildirilip bildirilmediğini döndürür.
+func (c ParticipantCompanion) IsChild() bool {
+	return c.AgeGroup == CompanionChild
+}
+
+// SeatedAt, refakatçinin katılımcıdan ayrı olarak verilen masaya atanıp atanmadığını döndürür.
+func (c ParticipantCompanion) SeatedAt(tableID uint) bool {
+	return c.TableID != nil && *c.TableID == tableID
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"slices"
+	"strings"
+)
+
+// RSVPQuestionType, katılım formundaki sorunun yanıt biçimidir.
+type RSVPQuestionType string
+
+const (
+	RSVPQuestionText         RSVPQuestionType = "text"
+	RSVPQuestionSingleChoice RSVPQuestionType = "single_choice"
+	RSVPQuestionMultiChoice  RSVPQuestionType = "multi_choice"
+	RSVPQuestionNumber       RSVPQuestionType = "number"
+)
+
+// RSVPQuestion, davet sahibinin katılım formuna eklediği sorudur (ör: menü tercihi, alerji, servis ihtiyacı).
+// Seçenekli sorularda seçenekler Options'ta satır satır tutulur.
+type RSVPQuestion struct {
+	BaseModel
+	InvitationID uint             `gorm:"not null;index:idx_rsvp_questions_order,priority:1"`
+	SortOrder    int              `gorm:"not null;default:0;index:idx_rsvp_questions_order,priority:2"`
+	Label        string           `gorm:"size:255;not null"`
+	Type         RSVPQuestionType `gorm:"size:16;not null"`
+	Options      string           `gorm:"type:text"`
+	Required     bool             `gorm:"not null;default:false"`
+
+	Invitation *Invitation `gorm:"foreignKey:InvitationID"`
+}
+
+// TableName returns the table name for the RSVPQuestion model
+func (RSVPQuestion) TableName() string {
+	return "rsvp_questions"
+}
+
+// Choices, seçenekli sorunun seçeneklerini döndürür.
+func (q RSVPQuestion) Choices() []string {
+	if q.Options == "" {
+		return nil
+	}
+	return strings.Split(q.Options, "\n")
+}
+
+// IsChoice, sorunun seçenekli olup olmadığını bildirir.
+func (q RSVPQuestion) IsChoice() bool {
+	return q.Type == RSVPQuestionSingleChoice || q.Type == RSVPQuestionMultiChoice
+}
+
+// RSVPAnswer, katılımcının bir soruya verdiği yanıttır. Çoklu seçimlerde seçilenler Value'da satır satır tutulur.
+// Katılımcı bildirimini yenilediğinde yanıtları baştan yazılır.
+type RSVPAnswer struct {
+	ID            uint   `gorm:"primarykey"`
+	ParticipantID uint   `gorm:"not null;uniqueIndex:idx_rsvp_answers_participant_question,priority:1"`
+	QuestionID    uint   `gorm:"not null;uniqueIndex:idx_rsvp_answers_participant_question,priority:2;index"`
+	Value         string `gorm:"type:text;not null"`
+}
+
+// TableName returns the table name for the RSVPAnswer model
+func (RSVPAnswer) TableName() string {
+	return "rsvp_answers"
+}
+
+// Values, yanıtı seçimlere ayırır; tek değerli yanıtlarda tek eleman döner.
+func (a RSVPAnswer) Values() []string {
+	return strings.Split(a.Value, "\n")
+}
+
+// Has, seçimin yanıtta bulunup bulunmadığını bildirir.
+func (a RSVPAnswer) Has(value string) bool {
+	return slices.Contains(a.Values(), value)
+}
+
+// Text, yanıtı listelerde gösterilecek biçimde (seçimler virgülle ayrılmış) döndürür.
+func (a RSVPAnswer) Text() string {
+	return strings.Join(a.Values(), ", ")
+}
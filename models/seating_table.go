@@ -0,0 +1,18 @@
+package models
+
+// SeatingTable, davetiyenin oturma planındaki masasıdır. Katılımcılar masalara InvitationParticipant.TableID ile,
+// katılımcıdan ayrı oturacak refakatçiler ParticipantCompanion.TableID ile atanır.
+type SeatingTable struct {
+	BaseModel
+	InvitationID uint   `gorm:"not null;index:idx_seating_tables_order,priority:1"`
+	SortOrder    int    `gorm:"not null;default:0;index:idx_seating_tables_order,priority:2"`
+	Name         string `gorm:"size:50;not null"` // Ör: "Masa 1", "Gelin masası"
+	Capacity     int    `gorm:"not null"`         // Kişi; aşılabilir, aşıldığında uyarı gösterilir
+
+	Invitation *Invitation `gorm:"foreignKey:InvitationID"`
+}
+
+// TableName returns the table name for the SeatingTable model
+func (SeatingTable) TableName() string {
+	return "seating_tables"
+}
@@ -35,6 +35,8 @@ type User struct {
 	VerificationToken string       `gorm:"size:255;index"`
 	Provider          string       `gorm:"size:50;index"`
 	ProviderID        string       `gorm:"size:100;index"`
+	CalendarToken     string       `gorm:"size:64;index"`
+	Locale            string       `gorm:"size:5"`
 }
 
 func (u *User) CheckPassword(password string) error {
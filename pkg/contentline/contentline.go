@@ -0,0 +1,34 @@
+// Package contentline, iCalendar (RFC 5545) ve vCard (RFC 6350) dosyalarının ortak satır kurallarını uygular.
+package contentline
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Satırlar CRLF ile biter ve 75 oktetten uzunsa katlanır.
+const (
+	LineEnding = "\r\n"
+	MaxOctets  = 75
+)
+
+// Fold, satırı çok baytlı karakterleri bölmeden 75 oktetlik parçalara ayırır; devam satırları bir boşlukla başlar.
+func Fold(line string) string {
+	if len(line) <= MaxOctets {
+		return line
+	}
+	var b strings.Builder
+	limit := MaxOctets
+	width := 0
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if width+size > limit {
+			b.WriteString(LineEnding + " ")
+			width = 0
+			limit = MaxOctets - 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
@@ -0,0 +1,52 @@
+package contentline
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestFoldShortLine(t *testing.T) {
+	line := strings.Repeat("a", MaxOctets)
+	if got := Fold(line); got != line {
+		t.Fatalf("75 oktetlik satır katlanmamalıydı: %q", got)
+	}
+}
+
+func TestFoldNeverSplitsRunes(t *testing.T) {
+	// Çok baytlı harf, ilk satırın ve devam satırlarının sınırına her konumda denk getirilir
+	for _, r := range []string{"ğ", "İ", "€", "😀"} {
+		for prefix := MaxOctets - 4; prefix <= 2*MaxOctets; prefix++ {
+			line := "SUMMARY:" + strings.Repeat("a", prefix-8) + strings.Repeat(r, 40)
+			folded := Fold(line)
+			for i, part := range strings.Split(folded, LineEnding) {
+				if i > 0 && !strings.HasPrefix(part, " ") {
+					t.Fatalf("%q, önek %d: devam satırı boşlukla başlamıyor: %q", r, prefix, part)
+				}
+				if len(part) > MaxOctets {
+					t.Fatalf("%q, önek %d: %d. satır %d oktet", r, prefix, i, len(part))
+				}
+				if !utf8.ValidString(part) {
+					t.Fatalf("%q, önek %d: %d. satırda bölünmüş harf var: %q", r, prefix, i, part)
+				}
+			}
+			if unfolded := strings.ReplaceAll(folded, LineEnding+" ", ""); unfolded != line {
+				t.Fatalf("%q, önek %d: açılan satır özgün satırla aynı değil", r, prefix)
+			}
+		}
+	}
+}
+
+func TestFoldUsesFullWidth(t *testing.T) {
+	// İlk satır 75, devam satırları baştaki boşlukla birlikte 75 oktettir
+	parts := strings.Split(Fold(strings.Repeat("a", 200)), LineEnding)
+	want := []int{75, 75, 52}
+	if len(parts) != len(want) {
+		t.Fatalf("%d satır, %d bekleniyordu", len(parts), len(want))
+	}
+	for i, part := range parts {
+		if len(part) != want[i] {
+			t.Errorf("%d. satır %d oktet, %d bekleniyordu", i, len(part), want[i])
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package csvimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+var (
+	ErrEmpty       = errors.New("dosyada satır bulunamadı")
+	ErrTooManyRows = errors.New("dosyada izin verilenden fazla satır var")
+	ErrInvalid     = errors.New("geçersiz CSV dosyası")
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// Read, Excel veya Google E-Tablolar'dan dışa aktarılan CSV dosyasını satırlara ayırır.
+// UTF-8 BOM atlanır; UTF-8 olmayan dosyalar Türkçe Excel'in varsayılanı olan Windows-1254 kabul edilir.
+// Ayraç ilk satıra göre virgül, noktalı virgül veya sekme olarak tahmin edilir. Boş satırlar atlanır.
+func Read(r io.Reader, maxRows int) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	data = bytes.TrimPrefix(data, utf8BOM)
+	if !utf8.Valid(data) {
+		if data, err = charmap.Windows1254.NewDecoder().Bytes(data); err != nil {
+			return nil, ErrInvalid
+		}
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	var rows [][]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, ErrInvalid
+		}
+		empty := true
+		for i := range record {
+			record[i] = strings.TrimSpace(record[i])
+			if record[i] != "" {
+				empty = false
+			}
+		}
+		if empty {
+			continue
+		}
+		if maxRows > 0 && len(rows) >= maxRows {
+			return nil, ErrTooManyRows
+		}
+		rows = append(rows, record)
+	}
+	if len(rows) == 0 {
+		return nil, ErrEmpty
+	}
+	return rows, nil
+}
+
+// Write, satırları standart (virgül ayraçlı, UTF-8) CSV olarak yazar.
+func Write(w io.Writer, rows [][]string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// detectDelimiter, tırnak dışındaki ayraçları ilk satırda sayar; eşitlikte virgül seçilir.
+func detectDelimiter(data []byte) rune {
+	line := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		line = data[:i]
+	}
+	counts := map[rune]int{}
+	quoted := false
+	for _, r := range string(line) {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case !quoted && (r == ',' || r == ';' || r == '\t'):
+			counts[r]++
+		}
+	}
+	delimiter := ','
+	for _, candidate := range []rune{';', '\t'} {
+		if counts[candidate] > counts[delimiter] {
+			delimiter = candidate
+		}
+	}
+	return delimiter
+}
@@ -0,0 +1,118 @@
+package csvimport
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadDelimiters(t *testing.T) {
+	guest := []string{"Ayşe", "Yılmaz", "0532 123 45 67"}
+	tests := []struct {
+		name   string
+		data   string
+		header []string
+	}{
+		{"virgül", "Ad,Soyad,Telefon\nAyşe,Yılmaz,0532 123 45 67\n", []string{"Ad", "Soyad", "Telefon"}},
+		{"noktalı virgül", "Ad;Soyad;Telefon\r\nAyşe;Yılmaz;0532 123 45 67\r\n", []string{"Ad", "Soyad", "Telefon"}},
+		{"sekme", "Ad\tSoyad\tTelefon\nAyşe\tYılmaz\t0532 123 45 67", []string{"Ad", "Soyad", "Telefon"}},
+		{"tırnak içindeki ayraç sayılmaz", "\"Ad, Soyad\";Soyad;Telefon\nAyşe;Yılmaz;0532 123 45 67\n", []string{"Ad, Soyad", "Soyad", "Telefon"}},
+	}
+	for _, tt := range tests {
+		rows, err := Read(strings.NewReader(tt.data), 0)
+		if err != nil {
+			t.Errorf("%s: hata = %v", tt.name, err)
+			continue
+		}
+		if want := [][]string{tt.header, guest}; !reflect.DeepEqual(rows, want) {
+			t.Errorf("%s: satırlar = %q, %q bekleniyordu", tt.name, rows, want)
+		}
+	}
+}
+
+func TestDetectDelimiter(t *testing.T) {
+	tests := []struct {
+		line string
+		want rune
+	}{
+		{"a,b,c", ','},
+		{"a;b;c", ';'},
+		{"a\tb\tc", '\t'},
+		{"tek sütun", ','},
+		{"a;b,c", ','},
+		{"a;b;c,d", ';'},
+		{"\"1,5\";\"2,5\"", ';'},
+		{"a;b\nc,d,e,f", ';'},
+	}
+	for _, tt := range tests {
+		if got := detectDelimiter([]byte(tt.line)); got != tt.want {
+			t.Errorf("detectDelimiter(%q) = %q, %q bekleniyordu", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestReadEncodings(t *testing.T) {
+	want := [][]string{{"Şükrü", "Çağlayan"}, {"İlknur", "Işık"}}
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"UTF-8", []byte("Şükrü;Çağlayan\nİlknur;Işık\n")},
+		{"UTF-8 BOM", append([]byte{0xEF, 0xBB, 0xBF}, "Şükrü;Çağlayan\nİlknur;Işık\n"...)},
+		// Türkçe Excel'in "CSV (noktalı virgülle ayrılmış)" çıktısı
+		{"Windows-1254", []byte("\xDE\xFCkr\xFC;\xC7a\xF0layan\r\n\xDDlknur;I\xfe\xfdk\r\n")},
+	}
+	for _, tt := range tests {
+		rows, err := Read(bytes.NewReader(tt.data), 0)
+		if err != nil {
+			t.Errorf("%s: hata = %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(rows, want) {
+			t.Errorf("%s: satırlar = %q, %q bekleniyordu", tt.name, rows, want)
+		}
+	}
+}
+
+func TestReadSkipsEmptyRowsAndTrims(t *testing.T) {
+	rows, err := Read(strings.NewReader("Ad;Telefon\n;\n\n  Ali  ; 0532 \n;;\n"), 0)
+	if err != nil {
+		t.Fatalf("hata = %v", err)
+	}
+	want := [][]string{{"Ad", "Telefon"}, {"Ali", "0532"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("satırlar = %q, %q bekleniyordu", rows, want)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	if _, err := Read(strings.NewReader(""), 0); !errors.Is(err, ErrEmpty) {
+		t.Errorf("boş dosya hatası = %v, ErrEmpty bekleniyordu", err)
+	}
+	if _, err := Read(strings.NewReader("\xEF\xBB\xBF\n,,\n \n"), 0); !errors.Is(err, ErrEmpty) {
+		t.Errorf("yalnızca boş satırlı dosya hatası = %v, ErrEmpty bekleniyordu", err)
+	}
+	if _, err := Read(strings.NewReader("a\nb\nc\n"), 2); !errors.Is(err, ErrTooManyRows) {
+		t.Errorf("satır sınırı hatası = %v, ErrTooManyRows bekleniyordu", err)
+	}
+	if rows, err := Read(strings.NewReader("a\n\nb\n"), 2); err != nil || len(rows) != 2 {
+		t.Errorf("boş satırlar sınıra sayıldı: %q, %v", rows, err)
+	}
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	rows := [][]string{{"Ad", "Not"}, {"Ayşe", "Masa 3, \"VIP\""}}
+	var buf bytes.Buffer
+	if err := Write(&buf, rows); err != nil {
+		t.Fatalf("hata = %v", err)
+	}
+	got, err := Read(&buf, 0)
+	if err != nil {
+		t.Fatalf("okuma hatası = %v", err)
+	}
+	if !reflect.DeepEqual(got, rows) {
+		t.Errorf("satırlar = %q, %q bekleniyordu", got, rows)
+	}
+}
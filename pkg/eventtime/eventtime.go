@@ -0,0 +1,148 @@
+package eventtime
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	// Sunucuda zoneinfo kurulu olmasa da IANA saat dilimleri yüklenebilsin
+	_ "time/tzdata"
+)
+
+var (
+	ErrInvalidDate = errors.New("geçersiz tarih")
+	ErrInvalidTime = errors.New("geçersiz saat")
+	ErrInvalidZone = errors.New("geçersiz saat dilimi")
+)
+
+// Türkçe ay adları; kısaltmalar da kabul edilir (ör: "Oca", "Şub")
+var months = map[string]time.Month{
+	"ocak": time.January, "oca": time.January,
+	"şubat": time.February, "şub": time.February,
+	"mart": time.March, "mar": time.March,
+	"nisan": time.April, "nis": time.April,
+	"mayıs": time.May, "may": time.May,
+	"haziran": time.June, "haz": time.June,
+	"temmuz": time.July, "tem": time.July,
+	"ağustos": time.August, "ağu": time.August,
+	"eylül": time.September, "eyl": time.September,
+	"ekim": time.October, "eki": time.October,
+	"kasım": time.November, "kas": time.November,
+	"aralık": time.December, "ara": time.December,
+}
+
+var (
+	// 2025-06-21 (tarayıcının tarih alanı)
+	isoDatePattern = regexp.MustCompile(`^(\d{4})-(\d{1,2})-(\d{1,2})$`)
+	// 21.06.2025, 21/06/2025, 21-06-2025
+	numericDatePattern = regexp.MustCompile(`^(\d{1,2})[./-](\d{1,2})[./-](\d{4})$`)
+	// 21 Haziran 2025 (sonundaki gün adı yok sayılır: "21 Haziran 2025 Cumartesi")
+	textDatePattern = regexp.MustCompile(`^(\d{1,2})\s+(\p{L}+)\s+(\d{4})(?:\s+\p{L}+)?$`)
+	// 19:30, 19.30, 19:30:00
+	clockPattern = regexp.MustCompile(`^(\d{1,2})[:.](\d{2})(?::\d{2})?$`)
+)
+
+// Date, Türkçe yazımlarla girilmiş tarihi verilen saat diliminde gece yarısı olarak döndürür.
+func Date(value string, loc *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	var day, month, year int
+	if m := isoDatePattern.FindStringSubmatch(value); m != nil {
+		year, month, day = atoi(m[1]), atoi(m[2]), atoi(m[3])
+	} else if m := numericDatePattern.FindStringSubmatch(value); m != nil {
+		day, month, year = atoi(m[1]), atoi(m[2]), atoi(m[3])
+	} else if m := textDatePattern.FindStringSubmatch(value); m != nil {
+		named, ok := months[strings.ToLowerSpecial(unicode.TurkishCase, m[2])]
+		if !ok {
+			return time.Time{}, ErrInvalidDate
+		}
+		day, month, year = atoi(m[1]), int(named), atoi(m[3])
+	} else {
+		return time.Time{}, ErrInvalidDate
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+	// 31.02.2025 gibi taşan tarihler reddedilir
+	if date.Year() != year || int(date.Month()) != month || date.Day() != day {
+		return time.Time{}, ErrInvalidDate
+	}
+	return date, nil
+}
+
+// Clock, "19:30" veya "19.30" biçimindeki saati saat ve dakika olarak döndürür.
+func Clock(value string) (int, int, error) {
+	m := clockPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return 0, 0, ErrInvalidTime
+	}
+	hour, minute := atoi(m[1]), atoi(m[2])
+	if hour > 23 || minute > 59 {
+		return 0, 0, ErrInvalidTime
+	}
+	return hour, minute, nil
+}
+
+// At, tarih ve saati birleştirir; saat boşsa günün başlangıcı döner ve allDay true olur.
+func At(date, clock string, loc *time.Location) (t time.Time, allDay bool, err error) {
+	day, err := Date(date, loc)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if strings.TrimSpace(clock) == "" {
+		return day, true, nil
+	}
+	hour, minute, err := Clock(clock)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc), false, nil
+}
+
+// CommonZones, formlarda önerilen saat dilimleri; Türkiye ve diasporanın yoğun olduğu ülkeler öndedir.
+var CommonZones = []string{
+	"Europe/Istanbul",
+	"Europe/Berlin",
+	"Europe/Amsterdam",
+	"Europe/Brussels",
+	"Europe/Vienna",
+	"Europe/Zurich",
+	"Europe/Paris",
+	"Europe/London",
+	"Europe/Stockholm",
+	"Asia/Baku",
+	"Asia/Dubai",
+	"America/New_York",
+	"America/Chicago",
+	"America/Los_Angeles",
+	"America/Toronto",
+	"Australia/Sydney",
+	"UTC",
+}
+
+var zoneCache sync.Map
+
+// LoadLocation, IANA saat dilimini (ör: Europe/Istanbul) önbellekten veya tzdata'dan yükler.
+func LoadLocation(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	// "Local" ve boş ad sunucunun saat dilimine karşılık geldiğinden kabul edilmez
+	if name == "" || name == "Local" {
+		return nil, ErrInvalidZone
+	}
+	if loc, ok := zoneCache.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidZone
+	}
+	zoneCache.Store(name, loc)
+	return loc, nil
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
@@ -0,0 +1,159 @@
+package eventtime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDate(t *testing.T) {
+	istanbul, err := LoadLocation("Europe/Istanbul")
+	if err != nil {
+		t.Fatalf("Europe/Istanbul yüklenemedi: %v", err)
+	}
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"2025-06-21", "2025-06-21"},
+		{"2025-6-1", "2025-06-01"},
+		{"21.06.2025", "2025-06-21"},
+		{"1.6.2025", "2025-06-01"},
+		{"21/06/2025", "2025-06-21"},
+		{"21-06-2025", "2025-06-21"},
+		{"  21.06.2025  ", "2025-06-21"},
+		{"29.02.2024", "2024-02-29"},
+		{"21 Haziran 2025", "2025-06-21"},
+		{"21 haziran 2025 Cumartesi", "2025-06-21"},
+		{"3 Şubat 2026", "2026-02-03"},
+		{"3 ŞUBAT 2026", "2026-02-03"},
+		{"14 Ağustos 2025", "2025-08-14"},
+		{"1 Eylül 2025", "2025-09-01"},
+		{"30 KASIM 2025", "2025-11-30"},
+		{"31 Aralık 2025", "2025-12-31"},
+		{"5 Oca 2026", "2026-01-05"},
+		{"9 Eki 2025", "2025-10-09"},
+	}
+	for _, tt := range tests {
+		got, err := Date(tt.value, istanbul)
+		if err != nil {
+			t.Errorf("Date(%q) hata = %v", tt.value, err)
+			continue
+		}
+		if got.Format("2006-01-02") != tt.want || got.Location() != istanbul || got.Hour() != 0 || got.Minute() != 0 {
+			t.Errorf("Date(%q) = %v, %s gece yarısı (Europe/Istanbul) bekleniyordu", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDateRejects(t *testing.T) {
+	for _, value := range []string{
+		"",
+		"yarın",
+		"31.02.2025",
+		"29.02.2025",
+		"31.04.2025",
+		"00.06.2025",
+		"21.13.2025",
+		"2025-00-10",
+		"21.06.25",
+		"21 Haziram 2025",
+		"21 June 2025",
+		"21 Haziran",
+		"Haziran 21 2025",
+		"21.06.2025 19:30",
+	} {
+		if _, err := Date(value, time.UTC); !errors.Is(err, ErrInvalidDate) {
+			t.Errorf("Date(%q) hata = %v, ErrInvalidDate bekleniyordu", value, err)
+		}
+	}
+}
+
+func TestClock(t *testing.T) {
+	tests := []struct {
+		value        string
+		hour, minute int
+	}{
+		{"19:30", 19, 30},
+		{"19.30", 19, 30},
+		{"9:05", 9, 5},
+		{"00:00", 0, 0},
+		{"23:59", 23, 59},
+		{"19:30:45", 19, 30},
+		{" 08:15 ", 8, 15},
+	}
+	for _, tt := range tests {
+		hour, minute, err := Clock(tt.value)
+		if err != nil || hour != tt.hour || minute != tt.minute {
+			t.Errorf("Clock(%q) = %d, %d, %v; %d, %d bekleniyordu", tt.value, hour, minute, err, tt.hour, tt.minute)
+		}
+	}
+}
+
+func TestClockRejects(t *testing.T) {
+	for _, value := range []string{"", "24:00", "12:60", "7", "19:3", "19-30", "akşam", "19:30 PM"} {
+		if _, _, err := Clock(value); !errors.Is(err, ErrInvalidTime) {
+			t.Errorf("Clock(%q) hata = %v, ErrInvalidTime bekleniyordu", value, err)
+		}
+	}
+}
+
+func TestAt(t *testing.T) {
+	berlin, err := LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Europe/Berlin yüklenemedi: %v", err)
+	}
+	tests := []struct {
+		date, clock string
+		want        time.Time
+		allDay      bool
+	}{
+		{"21.06.2025", "19:30", time.Date(2025, 6, 21, 19, 30, 0, 0, berlin), false},
+		{"21 Haziran 2025", "19.30", time.Date(2025, 6, 21, 19, 30, 0, 0, berlin), false},
+		{"21.06.2025", "", time.Date(2025, 6, 21, 0, 0, 0, 0, berlin), true},
+		{"21.06.2025", "   ", time.Date(2025, 6, 21, 0, 0, 0, 0, berlin), true},
+		// Yaz saatine geçilen gün de duvar saatine göre çözümlenir
+		{"30.03.2025", "12:00", time.Date(2025, 3, 30, 12, 0, 0, 0, berlin), false},
+	}
+	for _, tt := range tests {
+		got, allDay, err := At(tt.date, tt.clock, berlin)
+		if err != nil || !got.Equal(tt.want) || allDay != tt.allDay {
+			t.Errorf("At(%q, %q) = %v, %v, %v; %v, %v bekleniyordu", tt.date, tt.clock, got, allDay, err, tt.want, tt.allDay)
+		}
+	}
+	if got, _, _ := At("30.03.2025", "12:00", berlin); got.UTC().Hour() != 10 {
+		t.Errorf("Berlin yaz saatinde 12:00 = %v UTC, 10:00 bekleniyordu", got.UTC())
+	}
+
+	if _, _, err := At("31.02.2025", "19:30", berlin); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("geçersiz tarih hatası = %v, ErrInvalidDate bekleniyordu", err)
+	}
+	if _, _, err := At("21.06.2025", "25:00", berlin); !errors.Is(err, ErrInvalidTime) {
+		t.Errorf("geçersiz saat hatası = %v, ErrInvalidTime bekleniyordu", err)
+	}
+}
+
+func TestLoadLocation(t *testing.T) {
+	for _, name := range CommonZones {
+		loc, err := LoadLocation(name)
+		if err != nil {
+			t.Errorf("LoadLocation(%q) hata = %v", name, err)
+			continue
+		}
+		if loc.String() != name {
+			t.Errorf("LoadLocation(%q) = %q", name, loc.String())
+		}
+	}
+
+	first, _ := LoadLocation("Europe/Istanbul")
+	second, _ := LoadLocation(" Europe/Istanbul ")
+	if first != second {
+		t.Error("aynı saat dilimi önbellekten dönmedi")
+	}
+
+	for _, name := range []string{"", "  ", "Local", "Europe/Ankara", "Istanbul", "../etc/passwd"} {
+		if _, err := LoadLocation(name); !errors.Is(err, ErrInvalidZone) {
+			t.Errorf("LoadLocation(%q) hata = %v, ErrInvalidZone bekleniyordu", name, err)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package flashmessages
 import (
 	"davet.link/configs/logconfig"
 	"davet.link/configs/sessionconfig"
+	"davet.link/pkg/i18n"
 
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/zap"
@@ -29,13 +30,14 @@ type FlashMessagesData struct {
 	Error   string
 }
 
+// SetFlashMessage, mesajı isteğin diline çevirerek saklar.
 func SetFlashMessage(c *fiber.Ctx, key string, message string) error {
 	sess, err := sessionconfig.SessionStart(c)
 	if err != nil {
 		logconfig.Log.Error("Flash mesajı için session başlatılamadı", zap.Error(err))
 		return ErrSessionStartFailed
 	}
-	sess.Set(key, message)
+	sess.Set(key, i18n.Translate(c, message))
 	if err := sess.Save(); err != nil {
 		logconfig.Log.Error("Flash mesajı için session kaydedilemedi", zap.Error(err))
 		return ErrSessionSaveFailed
@@ -0,0 +1,34 @@
+package htmlsanitizer
+
+import (
+	"html/template"
+	"sync"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+var (
+	policyOnce sync.Once
+	policy     *bluemonday.Policy
+)
+
+// contentPolicy, yönetim panelinden girilen içerik için biçimlendirme etiketlerine izin verir;
+// script, iframe, olay öznitelikleri ve javascript: bağlantıları temizlenir.
+func contentPolicy() *bluemonday.Policy {
+	policyOnce.Do(func() {
+		policy = bluemonday.UGCPolicy()
+		policy.AllowAttrs("class").Globally()
+		policy.AddTargetBlankToFullyQualifiedLinks(true)
+	})
+	return policy
+}
+
+// Sanitize, HTML içeriği güvenli hale getirir.
+func Sanitize(html string) string {
+	return contentPolicy().Sanitize(html)
+}
+
+// SafeHTML, temizlenmiş içeriği şablonda kaçışsız basılabilecek şekilde döndürür.
+func SafeHTML(html string) template.HTML {
+	return template.HTML(Sanitize(html))
+}
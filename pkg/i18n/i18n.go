@@ -0,0 +1,152 @@
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	TR = "tr"
+	EN = "en"
+	DE = "de"
+
+	DefaultLocale = TR
+	// Seçilen dilin tutulduğu session ve c.Locals anahtarı
+	LocaleKey = "locale"
+	// Dil seçimi için sorgu parametresi (ör: /ornek-davet?lang=de)
+	QueryParam = "lang"
+)
+
+// Supported, arayüzün sunulduğu dillerdir; sıralama dil seçicide de kullanılır.
+var Supported = []string{TR, EN, DE}
+
+var names = map[string]string{
+	TR: "Türkçe",
+	EN: "English",
+	DE: "Deutsch",
+}
+
+type Option struct {
+	Code string
+	Name string
+}
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// Mesaj kimliği Türkçe metnin kendisidir; bu nedenle Türkçe için katalog tutulmaz.
+var catalogs = map[string]map[string]string{}
+
+func init() {
+	for _, locale := range Supported {
+		if locale == DefaultLocale {
+			continue
+		}
+		data, err := localeFiles.ReadFile("locales/" + locale + ".json")
+		if err != nil {
+			panic(fmt.Sprintf("i18n: %s kataloğu bulunamadı: %v", locale, err))
+		}
+		messages := make(map[string]string)
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: %s kataloğu okunamadı: %v", locale, err))
+		}
+		catalogs[locale] = messages
+	}
+}
+
+// T, Türkçe metnin istenen dildeki karşılığını döndürür; karşılığı yoksa metnin kendisi kullanılır.
+// Argüman verilirse metin fmt biçim dizgisi olarak işlenir.
+func T(locale, message string, args ...interface{}) string {
+	translated := message
+	if catalog, ok := catalogs[locale]; ok {
+		if value, ok := catalog[message]; ok && value != "" {
+			translated = value
+		}
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(translated, args...)
+	}
+	return translated
+}
+
+// Normalize, "en-US" gibi dil etiketlerini desteklenen dil koduna çevirir; desteklenmiyorsa boş döner.
+func Normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	for _, locale := range Supported {
+		if tag == locale {
+			return locale
+		}
+	}
+	return ""
+}
+
+// Match, Accept-Language başlığındaki ağırlıklara göre desteklenen en uygun dili seçer.
+// Bölge kodu yok sayılır (de-AT → de); eşit ağırlıkta önce yazılan dil, uygun dil yoksa Türkçe seçilir.
+func Match(acceptLanguage string) string {
+	best, bestWeight := "", 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		weight, ok := quality(params)
+		if !ok {
+			continue
+		}
+		if locale := Normalize(tag); locale != "" && weight > bestWeight {
+			best, bestWeight = locale, weight
+		}
+	}
+	if best == "" {
+		return DefaultLocale
+	}
+	return best
+}
+
+// quality, dil etiketinin parametrelerindeki q ağırlığını döndürür; q yoksa 1 kabul edilir.
+// 0 ile 1 arasında olmayan ağırlıklar geçersizdir.
+func quality(params string) (float64, bool) {
+	for _, param := range strings.Split(params, ";") {
+		key, value, _ := strings.Cut(param, "=")
+		if !strings.EqualFold(strings.TrimSpace(key), "q") {
+			continue
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || !(weight >= 0 && weight <= 1) {
+			return 0, false
+		}
+		return weight, true
+	}
+	return 1, true
+}
+
+// Options, dil seçicide gösterilecek dilleri döndürür; kod verilirse yalnızca o diller listelenir.
+func Options(only ...string) []Option {
+	options := make([]Option, 0, len(Supported))
+	for _, locale := range Supported {
+		if len(only) > 0 && !slices.Contains(only, locale) {
+			continue
+		}
+		options = append(options, Option{Code: locale, Name: names[locale]})
+	}
+	return options
+}
+
+// FromCtx, LocaleMiddleware tarafından belirlenen dili döndürür.
+func FromCtx(c *fiber.Ctx) string {
+	if locale, ok := c.Locals(LocaleKey).(string); ok && locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// Translate, metni isteğin diline çevirir.
+func Translate(c *fiber.Ctx, message string, args ...interface{}) string {
+	return T(FromCtx(c), message, args...)
+}
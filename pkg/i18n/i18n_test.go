@@ -0,0 +1,70 @@
+package i18n
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"", TR},
+		{"tr-TR,tr;q=0.9", TR},
+		{"en", EN},
+		{"de", DE},
+		{"EN-us", EN},
+		{"en_GB", EN},
+		// Bölge kodu yok sayılır
+		{"de-AT", DE},
+		{"de-CH,fr;q=0.8", DE},
+		{"fr-FR,fr;q=0.9,de-AT;q=0.8,en;q=0.7", DE},
+		// Ağırlığa göre seçilir, sıraya göre değil
+		{"en;q=0.5,de;q=0.9", DE},
+		{"tr;q=0.1, en;q=0.2, de;q=0.15", EN},
+		{"en;q=0.8, de", DE},
+		{"de;q=0.800,en;q=0.801", EN},
+		{"en; q = 0.5 , de ; Q=0.6", DE},
+		{"en;level=1;q=0.1,de;q=0.5", DE},
+		// Eşit ağırlıkta önce yazılan seçilir
+		{"de,en", DE},
+		{"en;q=0.7,tr;q=0.7", EN},
+		// q=0 kabul edilmeyen dil demektir
+		{"en;q=0", TR},
+		{"de;q=0,en;q=0.1", EN},
+		// Geçersiz ağırlıklar yok sayılır
+		{"de;q=abc,en;q=0.1", EN},
+		{"de;q=2,en;q=0.1", EN},
+		{"de;q=-1,en;q=0.1", EN},
+		{"de;q=NaN,en;q=0.1", EN},
+		// Desteklenmeyen diller Türkçeye düşer
+		{"fr-FR,fr;q=0.9", TR},
+		{"*", TR},
+		{"ja;q=0.9,*;q=0.1", TR},
+		{"deu,english", TR},
+		{",,;q=0.5", TR},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.header); got != tt.want {
+			t.Errorf("Match(%q) = %q, %q bekleniyordu", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"tr", TR},
+		{" EN ", EN},
+		{"de-DE", DE},
+		{"de_AT", DE},
+		{"fr", ""},
+		{"", ""},
+		{"english", ""},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.tag); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, %q bekleniyordu", tt.tag, got, tt.want)
+		}
+	}
+}
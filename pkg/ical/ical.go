@@ -0,0 +1,160 @@
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"davet.link/pkg/contentline"
+)
+
+// Satır sonu ve katlama kuralları contentline paketindedir (RFC 5545).
+const (
+	utcLayout  = "20060102T150405Z"
+	dateLayout = "20060102"
+)
+
+type Event struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	URL          string
+	Start        time.Time
+	End          time.Time
+	AllDay       bool
+	Created      time.Time
+	LastModified time.Time
+	// Sıfırdan büyükse etkinlikten bu kadar önce hatırlatma yapılır
+	Alarm time.Duration
+}
+
+type Calendar struct {
+	Name            string
+	Description     string
+	RefreshInterval time.Duration
+	Events          []Event
+}
+
+func (c Calendar) Encode() []byte {
+	var b strings.Builder
+	write := func(line string) {
+		b.WriteString(contentline.Fold(line))
+		b.WriteString(contentline.LineEnding)
+	}
+
+	write("BEGIN:VCALENDAR")
+	write("VERSION:2.0")
+	write("PRODID:-//davet.link//Davetiye Takvimi//TR")
+	write("CALSCALE:GREGORIAN")
+	write("METHOD:PUBLISH")
+	if c.Name != "" {
+		write("X-WR-CALNAME:" + escapeText(c.Name))
+		write("NAME:" + escapeText(c.Name))
+	}
+	if c.Description != "" {
+		write("X-WR-CALDESC:" + escapeText(c.Description))
+	}
+	if c.RefreshInterval > 0 {
+		write("REFRESH-INTERVAL;VALUE=DURATION:" + formatDuration(c.RefreshInterval))
+		write("X-PUBLISHED-TTL:" + formatDuration(c.RefreshInterval))
+	}
+
+	stamp := time.Now().UTC().Format(utcLayout)
+	for _, e := range c.Events {
+		write("BEGIN:VEVENT")
+		write("UID:" + e.UID)
+		write("DTSTAMP:" + stamp)
+		if e.AllDay {
+			end := e.End
+			if !end.After(e.Start) {
+				end = e.Start.AddDate(0, 0, 1)
+			}
+			write("DTSTART;VALUE=DATE:" + e.Start.Format(dateLayout))
+			write("DTEND;VALUE=DATE:" + end.Format(dateLayout))
+		} else {
+			write("DTSTART:" + e.Start.UTC().Format(utcLayout))
+			if e.End.After(e.Start) {
+				write("DTEND:" + e.End.UTC().Format(utcLayout))
+			}
+		}
+		write("SUMMARY:" + escapeText(e.Summary))
+		if e.Description != "" {
+			write("DESCRIPTION:" + escapeText(e.Description))
+		}
+		if e.Location != "" {
+			write("LOCATION:" + escapeText(e.Location))
+		}
+		if e.URL != "" {
+			write("URL;VALUE=URI:" + e.URL)
+		}
+		if !e.Created.IsZero() {
+			write("CREATED:" + e.Created.UTC().Format(utcLayout))
+		}
+		// SEQUENCE yazılmaz; abone olunan takvimler değişikliği LAST-MODIFIED ile anlar
+		if !e.LastModified.IsZero() {
+			write("LAST-MODIFIED:" + e.LastModified.UTC().Format(utcLayout))
+		}
+		write("STATUS:CONFIRMED")
+		write("TRANSP:OPAQUE")
+		if e.Alarm > 0 {
+			write("BEGIN:VALARM")
+			write("ACTION:DISPLAY")
+			write("DESCRIPTION:" + escapeText(e.Summary))
+			write("TRIGGER:-" + formatDuration(e.Alarm))
+			write("END:VALARM")
+		}
+		write("END:VEVENT")
+	}
+	write("END:VCALENDAR")
+
+	return []byte(b.String())
+}
+
+// formatDuration, süreyi RFC 5545 DURATION biçimine (P1D, PT2H30M) çevirir.
+func formatDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	seconds := d / time.Second
+
+	var b strings.Builder
+	b.WriteString("P")
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if hours > 0 || minutes > 0 || seconds > 0 {
+		b.WriteString("T")
+		if hours > 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes > 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+		if seconds > 0 {
+			fmt.Fprintf(&b, "%dS", seconds)
+		}
+	}
+	if b.Len() == 1 {
+		return "PT0S"
+	}
+	return b.String()
+}
+
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		",", `\,`,
+		";", `\;`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(s)
+}
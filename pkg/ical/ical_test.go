@@ -0,0 +1,167 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"davet.link/pkg/contentline"
+)
+
+// eventLines, kodlanmış takvimdeki ilk VEVENT bloğunun katlaması açılmış satırlarını döner.
+func eventLines(t *testing.T, e Event) []string {
+	t.Helper()
+	encoded := string(Calendar{Events: []Event{e}}.Encode())
+	unfolded := strings.ReplaceAll(encoded, contentline.LineEnding+" ", "")
+	lines := strings.Split(strings.TrimSuffix(unfolded, contentline.LineEnding), contentline.LineEnding)
+	var event []string
+	inEvent := false
+	for _, line := range lines {
+		switch {
+		case line == "BEGIN:VEVENT":
+			inEvent = true
+		case line == "END:VEVENT":
+			return append(event, line)
+		}
+		if inEvent {
+			event = append(event, line)
+		}
+	}
+	t.Fatalf("VEVENT bloğu bulunamadı:\n%s", encoded)
+	return nil
+}
+
+func hasLine(lines []string, want string) bool {
+	for _, line := range lines {
+		if line == want {
+			return true
+		}
+	}
+	return false
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "PT0S"},
+		{30 * time.Second, "PT30S"},
+		{15 * time.Minute, "PT15M"},
+		{2*time.Hour + 30*time.Minute, "PT2H30M"},
+		{24 * time.Hour, "P1D"},
+		{36 * time.Hour, "P1DT12H"},
+		{7*24*time.Hour + time.Minute + time.Second, "P7DT1M1S"},
+		{-time.Hour, "PT1H"},
+	}
+	for _, tt := range tests {
+		if got := formatDuration(tt.in); got != tt.want {
+			t.Errorf("formatDuration(%v) = %q, %q bekleniyordu", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Düğün", "Düğün"},
+		{"Ayşe, Mehmet", `Ayşe\, Mehmet`},
+		{"Salon A; Kat 2", `Salon A\; Kat 2`},
+		{`C:\Davet`, `C:\\Davet`},
+		{"satır 1\nsatır 2", `satır 1\nsatır 2`},
+		{"satır 1\r\nsatır 2", `satır 1\nsatır 2`},
+		{"satır 1\rsatır 2", `satır 1\nsatır 2`},
+	}
+	for _, tt := range tests {
+		if got := escapeText(tt.in); got != tt.want {
+			t.Errorf("escapeText(%q) = %q, %q bekleniyordu", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeEvent(t *testing.T) {
+	istanbul := time.FixedZone("Europe/Istanbul", 3*60*60)
+	start := time.Date(2026, 6, 20, 19, 0, 0, 0, istanbul)
+	day := time.Date(2026, 6, 20, 0, 0, 0, 0, istanbul)
+
+	tests := []struct {
+		name   string
+		event  Event
+		want   []string
+		absent []string
+	}{
+		{
+			name:   "saatli etkinlik UTC yazılır",
+			event:  Event{UID: "1@davet.link", Summary: "Düğün", Start: start, End: start.Add(4 * time.Hour)},
+			want:   []string{"DTSTART:20260620T160000Z", "DTEND:20260620T200000Z"},
+			absent: []string{"BEGIN:VALARM"},
+		},
+		{
+			name:   "bitişi olmayan saatli etkinlikte DTEND yazılmaz",
+			event:  Event{UID: "2@davet.link", Summary: "Düğün", Start: start},
+			want:   []string{"DTSTART:20260620T160000Z"},
+			absent: []string{"DTEND:20260620T160000Z"},
+		},
+		{
+			name:  "tüm gün etkinliğinin bitişi ertesi gündür",
+			event: Event{UID: "3@davet.link", Summary: "Kına", Start: day, AllDay: true},
+			want:  []string{"DTSTART;VALUE=DATE:20260620", "DTEND;VALUE=DATE:20260621"},
+		},
+		{
+			name:  "birkaç günlük tüm gün etkinliği bitişini korur",
+			event: Event{UID: "4@davet.link", Summary: "Festival", Start: day, End: day.AddDate(0, 0, 3), AllDay: true},
+			want:  []string{"DTSTART;VALUE=DATE:20260620", "DTEND;VALUE=DATE:20260623"},
+		},
+		{
+			name:  "metin alanları kaçışlanır",
+			event: Event{UID: "5@davet.link", Summary: "Ayşe, Mehmet", Description: "Program;\nyemek", Location: `Salon\A`, Start: start},
+			want:  []string{`SUMMARY:Ayşe\, Mehmet`, `DESCRIPTION:Program\;\nyemek`, `LOCATION:Salon\\A`},
+		},
+		{
+			name:  "hatırlatma VALARM olarak eklenir",
+			event: Event{UID: "6@davet.link", Summary: "Nişan, akşam", Start: start, Alarm: 24 * time.Hour},
+			want: []string{
+				"BEGIN:VALARM", "ACTION:DISPLAY", `DESCRIPTION:Nişan\, akşam`, "TRIGGER:-P1D", "END:VALARM",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := eventLines(t, tt.event)
+			for _, want := range tt.want {
+				if !hasLine(lines, want) {
+					t.Errorf("%q satırı yok:\n%s", want, strings.Join(lines, "\n"))
+				}
+			}
+			for _, absent := range tt.absent {
+				if hasLine(lines, absent) {
+					t.Errorf("%q satırı olmamalıydı", absent)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeStampIsUTC(t *testing.T) {
+	before := time.Now().UTC().Truncate(time.Second)
+	lines := eventLines(t, Event{UID: "1@davet.link", Summary: "Düğün", Start: time.Now()})
+	after := time.Now().UTC()
+
+	for _, line := range lines {
+		value, ok := strings.CutPrefix(line, "DTSTAMP:")
+		if !ok {
+			continue
+		}
+		stamp, err := time.Parse(utcLayout, value)
+		if err != nil {
+			t.Fatalf("DTSTAMP UTC biçiminde değil: %q", value)
+		}
+		if stamp.Before(before) || stamp.After(after) {
+			t.Fatalf("DTSTAMP = %v, %v ile %v arasında bekleniyordu", stamp, before, after)
+		}
+		return
+	}
+	t.Fatal("DTSTAMP satırı yok")
+}
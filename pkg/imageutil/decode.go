@@ -0,0 +1,42 @@
+package imageutil
+
+import (
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"os"
+
+	_ "golang.org/x/image/webp"
+)
+
+// Yüklenebilecek en büyük görsel boyutu (piksel); bozuk veya kötü niyetli dosyalara karşı sınır
+const maxDecodePixels = 40_000_000
+
+// DecodeFile, jpeg/png/gif/webp dosyasını çözümler.
+func DecodeFile(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Decode(file)
+}
+
+// Decode, okuyucudaki görseli çözümler; boyut sınırı aşılıyorsa piksel verisi okunmaz.
+func Decode(r io.ReadSeeker) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width*cfg.Height > maxDecodePixels {
+		return nil, image.ErrFormat
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(r)
+	return img, err
+}
@@ -0,0 +1,101 @@
+package imageutil
+
+import (
+	"image"
+	"image/color"
+)
+
+// Resize, görseli alan ortalaması (box filter) ile verilen boyuta ölçekler.
+func Resize(src image.Image, width, height int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	bounds := src.Bounds()
+	if width <= 0 || height <= 0 || bounds.Empty() {
+		return dst
+	}
+
+	scaleX := float64(bounds.Dx()) / float64(width)
+	scaleY := float64(bounds.Dy()) / float64(height)
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + int(float64(y)*scaleY)
+		y1 := bounds.Min.Y + int(float64(y+1)*scaleY)
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + int(float64(x)*scaleX)
+			x1 := bounds.Min.X + int(float64(x+1)*scaleX)
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+			dst.SetNRGBA(x, y, averageColor(src, x0, y0, x1, y1))
+		}
+	}
+	return dst
+}
+
+// Fit, en-boy oranını koruyarak görseli maxWidth x maxHeight kutusuna sığdırır.
+func Fit(src image.Image, maxWidth, maxHeight int) *image.NRGBA {
+	bounds := src.Bounds()
+	if bounds.Empty() {
+		return image.NewNRGBA(image.Rect(0, 0, 0, 0))
+	}
+	width, height := maxWidth, bounds.Dy()*maxWidth/bounds.Dx()
+	if height > maxHeight {
+		width, height = bounds.Dx()*maxHeight/bounds.Dy(), maxHeight
+	}
+	return Resize(src, max(width, 1), max(height, 1))
+}
+
+func averageColor(src image.Image, x0, y0, x1, y1 int) color.NRGBA {
+	var r, g, b, a, n uint64
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			// RGBA önceden çarpılmış (premultiplied) 16 bit değer döndürür
+			cr, cg, cb, ca := src.At(x, y).RGBA()
+			r += uint64(cr)
+			g += uint64(cg)
+			b += uint64(cb)
+			a += uint64(ca)
+			n++
+		}
+	}
+	if n == 0 || a == 0 {
+		return color.NRGBA{}
+	}
+	return color.NRGBA{
+		R: uint8(r * 0xff / a),
+		G: uint8(g * 0xff / a),
+		B: uint8(b * 0xff / a),
+		A: uint8(a / n >> 8),
+	}
+}
+
+// Cover, görseli en-boy oranını koruyarak ortadan kırpar ve verilen boyutu tamamen doldurur.
+func Cover(src image.Image, width, height int) *image.NRGBA {
+	bounds := src.Bounds()
+	if bounds.Empty() || width <= 0 || height <= 0 {
+		return image.NewNRGBA(image.Rect(0, 0, max(width, 0), max(height, 0)))
+	}
+	cropW, cropH := bounds.Dx(), bounds.Dx()*height/width
+	if cropH > bounds.Dy() {
+		cropW, cropH = bounds.Dy()*width/height, bounds.Dy()
+	}
+	x0 := bounds.Min.X + (bounds.Dx()-cropW)/2
+	y0 := bounds.Min.Y + (bounds.Dy()-cropH)/2
+	return Resize(subImage(src, image.Rect(x0, y0, x0+cropW, y0+cropH)), width, height)
+}
+
+func subImage(src image.Image, rect image.Rectangle) image.Image {
+	if sub, ok := src.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); ok {
+		return sub.SubImage(rect)
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			dst.Set(x-rect.Min.X, y-rect.Min.Y, src.At(x, y))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,269 @@
+package ogimage
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+	"sync"
+
+	"davet.link/pkg/imageutil"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Open Graph için önerilen boyut (1.91:1)
+const (
+	Width  = 1200
+	Height = 630
+
+	padding     = 72
+	avatarSize  = 300
+	avatarGap   = 64
+	brandName   = "davet.link"
+	titleSize   = 64
+	textSize    = 36
+	brandSize   = 30
+	lineSpacing = 1.25
+)
+
+var (
+	brandTop    = color.NRGBA{R: 0x1f, G: 0x29, B: 0x37, A: 0xff}
+	brandBottom = color.NRGBA{R: 0x4c, G: 0x1d, B: 0x95, A: 0xff}
+	accent      = color.NRGBA{R: 0x84, G: 0xcc, B: 0x16, A: 0xff}
+	textColor   = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	mutedColor  = color.NRGBA{R: 0xe5, G: 0xe7, B: 0xeb, A: 0xff}
+)
+
+// Preview, önizleme görselinin içeriğidir.
+type Preview struct {
+	// Tüm alanı kaplayan arka plan (davetiye görseli)
+	Background image.Image
+	// Solda daire içinde gösterilen görsel (kartvizit fotoğrafı)
+	Avatar   image.Image
+	Title    string
+	Subtitle string
+	Lines    []string
+}
+
+type fontSet struct {
+	title, text, brand font.Face
+}
+
+var (
+	fontsOnce sync.Once
+	fonts     fontSet
+	fontsErr  error
+)
+
+func loadFonts() (fontSet, error) {
+	fontsOnce.Do(func() {
+		bold, err := opentype.Parse(gobold.TTF)
+		if err != nil {
+			fontsErr = err
+			return
+		}
+		regular, err := opentype.Parse(goregular.TTF)
+		if err != nil {
+			fontsErr = err
+			return
+		}
+		newFace := func(f *opentype.Font, size float64) font.Face {
+			face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+			if err != nil && fontsErr == nil {
+				fontsErr = err
+			}
+			return face
+		}
+		fonts = fontSet{
+			title: newFace(bold, titleSize),
+			text:  newFace(regular, textSize),
+			brand: newFace(bold, brandSize),
+		}
+	})
+	return fonts, fontsErr
+}
+
+// Render, önizlemeyi 1200x630 PNG olarak üretir.
+func Render(p Preview) ([]byte, error) {
+	faces, err := loadFonts()
+	if err != nil {
+		return nil, err
+	}
+
+	canvas := image.NewNRGBA(image.Rect(0, 0, Width, Height))
+	if p.Background != nil {
+		draw.Draw(canvas, canvas.Bounds(), imageutil.Cover(p.Background, Width, Height), image.Point{}, draw.Src)
+		darken(canvas)
+	} else {
+		verticalGradient(canvas, brandTop, brandBottom)
+	}
+
+	textLeft := padding
+	if p.Avatar != nil {
+		top := (Height - avatarSize) / 2
+		drawCircle(canvas, imageutil.Cover(p.Avatar, avatarSize, avatarSize), image.Pt(padding, top))
+		textLeft = padding + avatarSize + avatarGap
+	}
+	maxWidth := Width - textLeft - padding
+
+	// Metin bloğu dikeyde ortalanır
+	titleLines := wrap(faces.title, p.Title, maxWidth, 3)
+	subtitleLines := wrap(faces.text, p.Subtitle, maxWidth, 2)
+	var infoLines []string
+	for _, line := range p.Lines {
+		infoLines = append(infoLines, wrap(faces.text, line, maxWidth, 1)...)
+	}
+
+	titleHeight := int(titleSize * lineSpacing)
+	textHeight := int(textSize * lineSpacing)
+	blockHeight := len(titleLines)*titleHeight + (len(subtitleLines)+len(infoLines))*textHeight
+	if len(infoLines) > 0 {
+		blockHeight += textHeight / 2
+	}
+	y := (Height-blockHeight)/2 + titleSize
+
+	for _, line := range titleLines {
+		drawText(canvas, faces.title, line, textLeft, y, textColor)
+		y += titleHeight
+	}
+	for _, line := range subtitleLines {
+		drawText(canvas, faces.text, line, textLeft, y, mutedColor)
+		y += textHeight
+	}
+	if len(infoLines) > 0 {
+		y += textHeight / 2
+	}
+	for _, line := range infoLines {
+		drawText(canvas, faces.text, line, textLeft, y, mutedColor)
+		y += textHeight
+	}
+
+	// Marka adı sağ alt köşede, altında ince bir vurgu çizgisiyle
+	brandWidth := font.MeasureString(faces.brand, brandName).Ceil()
+	drawText(canvas, faces.brand, brandName, Width-padding-brandWidth, Height-padding+8, textColor)
+	draw.Draw(canvas, image.Rect(Width-padding-brandWidth, Height-padding+20, Width-padding, Height-padding+26), image.NewUniform(accent), image.Point{}, draw.Src)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, canvas); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func drawText(dst draw.Image, face font.Face, text string, x, y int, c color.Color) {
+	d := font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(text)
+}
+
+// wrap, metni kelime sınırlarından satırlara böler; sığmayan son satır üç noktayla kısaltılır.
+func wrap(face font.Face, text string, maxWidth, maxLines int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	var lines []string
+	current := ""
+	for i, word := range words {
+		candidate := word
+		if current != "" {
+			candidate = current + " " + word
+		}
+		if current == "" || font.MeasureString(face, candidate).Ceil() <= maxWidth {
+			current = candidate
+			continue
+		}
+		if len(lines) == maxLines-1 {
+			current = strings.Join(append([]string{current}, words[i:]...), " ")
+			break
+		}
+		lines = append(lines, current)
+		current = word
+	}
+	lines = append(lines, ellipsize(face, current, maxWidth))
+	return lines
+}
+
+func ellipsize(face font.Face, text string, maxWidth int) string {
+	if font.MeasureString(face, text).Ceil() <= maxWidth {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := strings.TrimSpace(string(runes)) + "…"
+		if font.MeasureString(face, candidate).Ceil() <= maxWidth {
+			return candidate
+		}
+	}
+	return "…"
+}
+
+func verticalGradient(img *image.NRGBA, top, bottom color.NRGBA) {
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		t := float64(y-b.Min.Y) / float64(b.Dy()-1)
+		c := color.NRGBA{
+			R: lerp(top.R, bottom.R, t),
+			G: lerp(top.G, bottom.G, t),
+			B: lerp(top.B, bottom.B, t),
+			A: 0xff,
+		}
+		for x := b.Min.X; x < b.Max.X; x++ {
+			img.SetNRGBA(x, y, c)
+		}
+	}
+}
+
+// darken, metnin okunabilmesi için arka planı soldan sağa azalan bir gölgeyle karartır.
+func darken(img *image.NRGBA) {
+	b := img.Bounds()
+	for x := b.Min.X; x < b.Max.X; x++ {
+		alpha := 0.75 - 0.35*float64(x-b.Min.X)/float64(b.Dx())
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			c := img.NRGBAAt(x, y)
+			c.R = uint8(float64(c.R) * (1 - alpha))
+			c.G = uint8(float64(c.G) * (1 - alpha))
+			c.B = uint8(float64(c.B) * (1 - alpha))
+			c.A = 0xff
+			img.SetNRGBA(x, y, c)
+		}
+	}
+}
+
+func drawCircle(dst *image.NRGBA, src *image.NRGBA, at image.Point) {
+	size := src.Bounds().Dx()
+	radius := float64(size) / 2
+	border := 6.0
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dx := float64(x) + 0.5 - radius
+			dy := float64(y) + 0.5 - radius
+			dist := dx*dx + dy*dy
+			switch {
+			case dist <= (radius-border)*(radius-border):
+				c := src.NRGBAAt(x, y)
+				c.A = 0xff
+				dst.SetNRGBA(at.X+x, at.Y+y, c)
+			case dist <= radius*radius:
+				dst.SetNRGBA(at.X+x, at.Y+y, textColor)
+			}
+		}
+	}
+}
+
+func lerp(a, b uint8, t float64) uint8 {
+	return uint8(float64(a) + (float64(b)-float64(a))*t)
+}
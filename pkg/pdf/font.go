@@ -0,0 +1,196 @@
+package pdf
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf16"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+type Style int
+
+const (
+	Regular Style = iota
+	Bold
+)
+
+var (
+	fontsOnce sync.Once
+	fontFiles [2]*opentype.Font
+	fontsErr  error
+)
+
+// Go yazı tipleri Türkçe dahil Latin, Yunan ve Kiril harflerini kapsar; her belgeye gömülür.
+var fontSources = [2]struct {
+	name string
+	ttf  []byte
+}{
+	Regular: {name: "GoRegular", ttf: goregular.TTF},
+	Bold:    {name: "GoBold", ttf: gobold.TTF},
+}
+
+func loadFonts() ([2]*opentype.Font, error) {
+	fontsOnce.Do(func() {
+		for i, source := range fontSources {
+			f, err := opentype.Parse(source.ttf)
+			if err != nil {
+				fontsErr = err
+				return
+			}
+			fontFiles[i] = f
+		}
+	})
+	return fontFiles, fontsErr
+}
+
+// fontUse, belgede bir yazı tipinden kullanılan glifleri tutar; genişlik ve ToUnicode tabloları bunlardan yazılır.
+type fontUse struct {
+	style  Style
+	font   *opentype.Font
+	buf    sfnt.Buffer
+	upem   float64
+	glyphs map[rune]glyph
+	used   map[sfnt.GlyphIndex]rune
+}
+
+type glyph struct {
+	index sfnt.GlyphIndex
+	// Glifin karşılık geldiği harf; yerine soru işareti kullanılan harflerde '?'
+	char rune
+	// 1000 birimlik em karesindeki ilerleme
+	width float64
+}
+
+func newFontUse(style Style, f *opentype.Font) *fontUse {
+	return &fontUse{
+		style:  style,
+		font:   f,
+		upem:   float64(f.UnitsPerEm()),
+		glyphs: make(map[rune]glyph),
+		used:   make(map[sfnt.GlyphIndex]rune),
+	}
+}
+
+// glyph, harfin glifini döndürür; yazı tipinde olmayan harfler yerine soru işareti kullanılır.
+func (u *fontUse) glyph(r rune) glyph {
+	if g, ok := u.glyphs[r]; ok {
+		return g
+	}
+	index, err := u.font.GlyphIndex(&u.buf, r)
+	if (err != nil || index == 0) && r != '?' {
+		g := u.glyph('?')
+		u.glyphs[r] = g
+		return g
+	}
+	advance, err := u.font.GlyphAdvance(&u.buf, index, fixed.I(int(u.upem)), font.HintingNone)
+	if err != nil {
+		advance = 0
+	}
+	g := glyph{index: index, char: r, width: float64(advance) / 64 * 1000 / u.upem}
+	u.glyphs[r] = g
+	return g
+}
+
+// width, metnin verilen puntodaki genişliğini döndürür.
+func (u *fontUse) width(s string, size float64) float64 {
+	total := 0.0
+	for _, r := range s {
+		total += u.glyph(r).width
+	}
+	return total * size / 1000
+}
+
+// encode, metni Identity-H kodlamasında iki baytlık glif numaralarına çevirir.
+func (u *fontUse) encode(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		g := u.glyph(r)
+		u.used[g.index] = g.char
+		fmt.Fprintf(&b, "%04X", uint16(g.index))
+	}
+	return b.String()
+}
+
+func (u *fontUse) scale(v fixed.Int26_6) int {
+	return int(float64(v) / 64 * 1000 / u.upem)
+}
+
+// writeObjects, yazı tipini Type0/CIDFontType2 olarak gömer ve Type0 nesnesinin numarasını döndürür.
+func (u *fontUse) writeObjects(w *objectWriter) (int, error) {
+	ppem := fixed.I(int(u.upem))
+	bounds, err := u.font.Bounds(&u.buf, ppem, font.HintingNone)
+	if err != nil {
+		return 0, err
+	}
+	metrics, err := u.font.Metrics(&u.buf, ppem, font.HintingNone)
+	if err != nil {
+		return 0, err
+	}
+	name := fontSources[u.style].name
+	flags := 32
+	stemV := 80
+	if u.style == Bold {
+		flags |= 1 << 18
+		stemV = 140
+	}
+
+	fileRef := w.stream(fontSources[u.style].ttf, fmt.Sprintf("/Length1 %d", len(fontSources[u.style].ttf)))
+	descriptorRef := w.object(fmt.Sprintf(
+		"<< /Type /FontDescriptor /FontName /%s /Flags %d /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV %d /FontFile2 %d 0 R >>",
+		name, flags,
+		u.scale(bounds.Min.X), -u.scale(bounds.Max.Y), u.scale(bounds.Max.X), -u.scale(bounds.Min.Y),
+		u.scale(metrics.Ascent), -u.scale(metrics.Descent), u.scale(metrics.CapHeight), stemV, fileRef,
+	))
+
+	indexes := make([]sfnt.GlyphIndex, 0, len(u.used))
+	for index := range u.used {
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+
+	var widths strings.Builder
+	for _, index := range indexes {
+		fmt.Fprintf(&widths, "%d [%.0f] ", index, u.glyph(u.used[index]).width)
+	}
+	cidRef := w.object(fmt.Sprintf(
+		"<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor %d 0 R /DW 1000 /W [%s] /CIDToGIDMap /Identity >>",
+		name, descriptorRef, strings.TrimSpace(widths.String()),
+	))
+	toUnicodeRef := w.stream([]byte(u.toUnicode(indexes)), "")
+	return w.object(fmt.Sprintf(
+		"<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+		name, cidRef, toUnicodeRef,
+	)), nil
+}
+
+// toUnicode, metnin kopyalanabilmesi ve aranabilmesi için glif numaralarını harflere eşleyen CMap'i üretir.
+func (u *fontUse) toUnicode(indexes []sfnt.GlyphIndex) string {
+	var b strings.Builder
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n")
+	b.WriteString("/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n")
+	b.WriteString("/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n")
+	b.WriteString("1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	// bfchar blokları en fazla 100 satır olabilir
+	for start := 0; start < len(indexes); start += 100 {
+		chunk := indexes[start:min(start+100, len(indexes))]
+		fmt.Fprintf(&b, "%d beginbfchar\n", len(chunk))
+		for _, index := range chunk {
+			fmt.Fprintf(&b, "<%04X> <", uint16(index))
+			for _, unit := range utf16.Encode([]rune{u.used[index]}) {
+				fmt.Fprintf(&b, "%04X", unit)
+			}
+			b.WriteString(">\n")
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return b.String()
+}
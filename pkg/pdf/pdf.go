@@ -0,0 +1,374 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf16"
+)
+
+// A4 dikey sayfa ölçüleri (pt)
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+	Margin     = 40.0
+
+	footerHeight = 20.0
+	footerSize   = 8.0
+	headingSize  = 16.0
+	textSize     = 10.0
+	tableSize    = 9.5
+	rowHeight    = 18.0
+	cellPadding  = 4.0
+)
+
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignRight
+	AlignCenter
+)
+
+type Column struct {
+	Title string
+	// Width, sütunun tablo genişliğindeki payıdır; paylar birbirine oranlanır
+	Width float64
+	Align Align
+}
+
+// Document, yukarıdan aşağıya akan başlık, metin ve tablolardan oluşan A4 belgedir.
+// Sığmayan içerik yeni sayfaya taşınır; tablo başlığı her sayfada tekrarlanır.
+type Document struct {
+	// Title, PDF bilgilerine yazılır
+	Title string
+	// Footer, her sayfanın altında solda gösterilir; sağda sayfa numarası yer alır
+	Footer string
+
+	fonts [2]*fontUse
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+	// Sayfanın üstünden itibaren yazılacak yerin konumu
+	y   float64
+	err error
+}
+
+func New(title string) *Document {
+	d := &Document{Title: title}
+	files, err := loadFonts()
+	if err != nil {
+		d.err = err
+		return d
+	}
+	for style, f := range files {
+		d.fonts[style] = newFontUse(Style(style), f)
+	}
+	d.AddPage()
+	return d
+}
+
+// AddPage, yeni bir sayfa açar ve konumu sayfanın başına alır.
+func (d *Document) AddPage() {
+	d.page = &bytes.Buffer{}
+	d.pages = append(d.pages, d.page)
+	d.y = Margin
+}
+
+// Heading, kalın ve büyük bir başlık satırı yazar.
+func (d *Document) Heading(text string) {
+	d.Paragraph(text, Bold, headingSize)
+	d.y += 4
+}
+
+// Text, metni sayfa genişliğine göre satırlara bölerek yazar.
+func (d *Document) Text(text string) {
+	d.Paragraph(text, Regular, textSize)
+}
+
+// Paragraph, metni verilen yazı tipi ve puntoyla satırlara bölerek yazar.
+func (d *Document) Paragraph(text string, style Style, size float64) {
+	if d.err != nil {
+		return
+	}
+	lineHeight := size * 1.35
+	for _, line := range d.wrap(text, style, size, PageWidth-2*Margin) {
+		d.ensureSpace(lineHeight)
+		d.text(Margin, d.y+size, line, style, size)
+		d.y += lineHeight
+	}
+}
+
+// Space, konumu verilen kadar aşağı kaydırır.
+func (d *Document) Space(height float64) {
+	d.y += height
+}
+
+// Table, satırları sütun başlığıyla birlikte yazar; footer boş değilse kalın bir toplam satırı olarak eklenir.
+// Hücreye sığmayan metin üç noktayla kısaltılır.
+func (d *Document) Table(columns []Column, rows [][]string, footer []string) {
+	if d.err != nil || len(columns) == 0 {
+		return
+	}
+	total := 0.0
+	for _, column := range columns {
+		total += column.Width
+	}
+	widths := make([]float64, len(columns))
+	for i, column := range columns {
+		widths[i] = column.Width / total * (PageWidth - 2*Margin)
+	}
+
+	header := func() {
+		d.rect(Margin, d.y, PageWidth-2*Margin, rowHeight, 0.92)
+		titles := make([]string, len(columns))
+		for i, column := range columns {
+			titles[i] = column.Title
+		}
+		d.row(columns, widths, titles, Bold)
+		d.line(Margin, d.y, PageWidth-Margin, d.y, 0.8, 0.4)
+	}
+
+	d.ensureSpace(2 * rowHeight)
+	header()
+	for _, cells := range rows {
+		if d.ensureSpace(rowHeight) {
+			header()
+		}
+		d.row(columns, widths, cells, Regular)
+		d.line(Margin, d.y, PageWidth-Margin, d.y, 0.35, 0.8)
+	}
+	if len(footer) > 0 {
+		if d.ensureSpace(rowHeight) {
+			header()
+		}
+		d.line(Margin, d.y, PageWidth-Margin, d.y, 0.8, 0.4)
+		d.row(columns, widths, footer, Bold)
+	}
+	d.y += rowHeight / 2
+}
+
+func (d *Document) row(columns []Column, widths []float64, cells []string, style Style) {
+	x := Margin
+	for i, column := range columns {
+		if i < len(cells) && cells[i] != "" {
+			available := widths[i] - 2*cellPadding
+			text := d.fit(cells[i], style, tableSize, available)
+			textX := x + cellPadding
+			switch column.Align {
+			case AlignRight:
+				textX += available - d.fonts[style].width(text, tableSize)
+			case AlignCenter:
+				textX += (available - d.fonts[style].width(text, tableSize)) / 2
+			}
+			d.text(textX, d.y+rowHeight/2+tableSize*0.35, text, style, tableSize)
+		}
+		x += widths[i]
+	}
+	d.y += rowHeight
+}
+
+// ensureSpace, verilen yükseklik sayfaya sığmıyorsa yeni sayfa açar ve açıldıysa true döner.
+func (d *Document) ensureSpace(height float64) bool {
+	if d.y+height <= PageHeight-Margin-footerHeight {
+		return false
+	}
+	d.AddPage()
+	return true
+}
+
+// TextWidth, metnin verilen yazı tipi ve puntodaki genişliğini döndürür.
+func (d *Document) TextWidth(text string, style Style, size float64) float64 {
+	if d.err != nil {
+		return 0
+	}
+	return d.fonts[style].width(clean(text), size)
+}
+
+func (d *Document) fit(text string, style Style, size, width float64) string {
+	text = clean(text)
+	f := d.fonts[style]
+	if f.width(text, size) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := strings.TrimRightFunc(string(runes), unicode.IsSpace) + "…"
+		if f.width(candidate, size) <= width {
+			return candidate
+		}
+	}
+	return ""
+}
+
+func (d *Document) wrap(text string, style Style, size, width float64) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(clean(text)) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if line != "" && d.fonts[style].width(candidate, size) > width {
+			lines = append(lines, d.fit(line, style, size, width))
+			candidate = word
+		}
+		line = candidate
+	}
+	if line != "" {
+		lines = append(lines, d.fit(line, style, size, width))
+	}
+	return lines
+}
+
+// text, metni sol üst köşeye göre verilen taban çizgisine yazar.
+func (d *Document) text(x, baseline float64, text string, style Style, size float64) {
+	fmt.Fprintf(d.page, "BT /F%d %.2f Tf %.2f %.2f Td <%s> Tj ET\n",
+		style+1, size, x, PageHeight-baseline, d.fonts[style].encode(text))
+}
+
+func (d *Document) line(x1, y1, x2, y2, width, gray float64) {
+	fmt.Fprintf(d.page, "%.2f w %.2f G %.2f %.2f m %.2f %.2f l S\n",
+		width, gray, x1, PageHeight-y1, x2, PageHeight-y2)
+}
+
+func (d *Document) rect(x, y, width, height, gray float64) {
+	fmt.Fprintf(d.page, "%.2f g %.2f %.2f %.2f %.2f re f 0 g\n",
+		gray, x, PageHeight-y-height, width, height)
+}
+
+// WriteTo, sayfa altlıklarını ekleyip belgeyi PDF olarak yazar.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	for i, page := range d.pages {
+		d.page = page
+		baseline := PageHeight - Margin/2 - footerSize
+		if d.Footer != "" {
+			d.text(Margin, baseline, d.fit(d.Footer, Regular, footerSize, (PageWidth-2*Margin)*0.75), Regular, footerSize)
+		}
+		number := fmt.Sprintf("%d / %d", i+1, len(d.pages))
+		d.text(PageWidth-Margin-d.fonts[Regular].width(number, footerSize), baseline, number, Regular, footerSize)
+	}
+
+	ow := &objectWriter{w: w}
+	ow.raw("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+	catalogRef := ow.reserve()
+	pagesRef := ow.reserve()
+
+	var resources strings.Builder
+	resources.WriteString("<< /Font <<")
+	for style, f := range d.fonts {
+		if len(f.used) == 0 {
+			continue
+		}
+		ref, err := f.writeObjects(ow)
+		if err != nil {
+			return ow.n, err
+		}
+		fmt.Fprintf(&resources, " /F%d %d 0 R", style+1, ref)
+	}
+	resources.WriteString(" >> >>")
+
+	kids := make([]string, len(d.pages))
+	for i, page := range d.pages {
+		contentRef := ow.stream(page.Bytes(), "")
+		pageRef := ow.object(fmt.Sprintf(
+			"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.2f %.2f] /Resources %s /Contents %d 0 R >>",
+			pagesRef, PageWidth, PageHeight, resources.String(), contentRef,
+		))
+		kids[i] = fmt.Sprintf("%d 0 R", pageRef)
+	}
+	ow.define(pagesRef, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	ow.define(catalogRef, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesRef))
+	infoRef := ow.object(fmt.Sprintf("<< /Title %s /Producer (davet.link) /CreationDate (D:%s) >>",
+		textString(d.Title), time.Now().UTC().Format("20060102150405Z")))
+
+	ow.finish(catalogRef, infoRef)
+	return ow.n, ow.err
+}
+
+// textString, metni PDF'in Unicode metin dizgisi (UTF-16BE) olarak kodlar.
+func textString(s string) string {
+	var b strings.Builder
+	b.WriteString("<FEFF")
+	for _, unit := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "%04X", unit)
+	}
+	b.WriteString(">")
+	return b.String()
+}
+
+// clean, satır sonu ve sekme gibi kontrol karakterlerini boşluğa çevirir.
+func clean(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+// objectWriter, nesneleri yazarken konumlarını tutar ve sonunda çapraz başvuru tablosunu yazar.
+type objectWriter struct {
+	w       io.Writer
+	n       int64
+	err     error
+	offsets []int64
+}
+
+func (o *objectWriter) raw(s string) {
+	if o.err != nil {
+		return
+	}
+	n, err := io.WriteString(o.w, s)
+	o.n += int64(n)
+	o.err = err
+}
+
+// reserve, sonradan tanımlanacak bir nesne numarası ayırır.
+func (o *objectWriter) reserve() int {
+	o.offsets = append(o.offsets, -1)
+	return len(o.offsets)
+}
+
+func (o *objectWriter) define(ref int, body string) {
+	o.offsets[ref-1] = o.n
+	o.raw(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", ref, body))
+}
+
+func (o *objectWriter) object(body string) int {
+	ref := o.reserve()
+	o.define(ref, body)
+	return ref
+}
+
+// stream, veriyi sıkıştırarak akış nesnesi olarak yazar; extra sözlüğe eklenir.
+func (o *objectWriter) stream(data []byte, extra string) int {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	_, _ = zw.Write(data)
+	_ = zw.Close()
+
+	ref := o.reserve()
+	o.offsets[ref-1] = o.n
+	o.raw(fmt.Sprintf("%d 0 obj\n<< /Length %d /Filter /FlateDecode %s>>\nstream\n", ref, compressed.Len(), extra+" "))
+	o.raw(compressed.String())
+	o.raw("\nendstream\nendobj\n")
+	return ref
+}
+
+func (o *objectWriter) finish(rootRef, infoRef int) {
+	start := o.n
+	o.raw(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", len(o.offsets)+1))
+	for _, offset := range o.offsets {
+		o.raw(fmt.Sprintf("%010d 00000 n \n", offset))
+	}
+	o.raw(fmt.Sprintf("trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(o.offsets)+1, rootRef, infoRef, start))
+}
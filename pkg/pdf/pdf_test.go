@@ -0,0 +1,255 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+const turkishSample = "Ağaç Şenliği İzmir'de, ılık bir akşam: ÇĞİÖŞÜ çğıöşü"
+
+// pdfObject, çapraz başvuru tablosundaki konumundan okunan nesnedir; akışlar açılmış olarak tutulur.
+type pdfObject struct {
+	dict   string
+	stream []byte
+}
+
+var (
+	refPattern     = `(\d+) 0 R`
+	lengthPattern  = regexp.MustCompile(`/Length (\d+)`)
+	fontRefPattern = regexp.MustCompile(`/F(\d) ` + refPattern)
+	showPattern    = regexp.MustCompile(`/F(\d) [\d.]+ Tf [\d.]+ [\d.]+ Td <([0-9A-F]*)> Tj`)
+	widthPattern   = regexp.MustCompile(`(\d+) \[(\d+)\]`)
+	bfcharPattern  = regexp.MustCompile(`<([0-9A-F]{4})> <([0-9A-F]+)>`)
+	blockPattern   = regexp.MustCompile(`(?s)(\d+) beginbfchar\n(.*?)endbfchar`)
+)
+
+func buildSampleDocument(t *testing.T) []byte {
+	t.Helper()
+	doc := New("Katılımcı Listesi - " + turkishSample)
+	doc.Footer = "davet.link/ğşİı · 01.06.2026"
+	doc.Heading(turkishSample)
+	// Yüzden fazla farklı glif kullanılarak ToUnicode'un birden çok bfchar bloğuna bölünmesi sağlanır
+	var sample strings.Builder
+	for r := rune('!'); r <= '~'; r++ {
+		sample.WriteRune(r)
+	}
+	sample.WriteString(" ÂâÎîÛû αβγδ абвгд €")
+	doc.Text(sample.String())
+	rows := make([][]string, 80)
+	for i := range rows {
+		rows[i] = []string{strconv.Itoa(i + 1), "Işıl Gümüşsoy " + strconv.Itoa(i), "İğdır"}
+	}
+	doc.Table([]Column{
+		{Title: "#", Width: 1, Align: AlignRight},
+		{Title: "Ad Soyad", Width: 6},
+		{Title: "Şehir", Width: 3},
+	}, rows, []string{"", "Toplam", "80"})
+
+	var buf bytes.Buffer
+	n, err := doc.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if int(n) != buf.Len() {
+		t.Fatalf("WriteTo %d bayt bildirdi, %d bayt yazıldı", n, buf.Len())
+	}
+	return buf.Bytes()
+}
+
+// readObjects, startxref ve çapraz başvuru tablosunu okuyup her kaydın gerçekten o nesneyi gösterdiğini doğrular.
+func readObjects(t *testing.T, data []byte) map[int]pdfObject {
+	t.Helper()
+	tail := data[bytes.LastIndex(data, []byte("startxref\n"))+len("startxref\n"):]
+	start, err := strconv.Atoi(string(tail[:bytes.IndexByte(tail, '\n')]))
+	if err != nil {
+		t.Fatalf("startxref okunamadı: %v", err)
+	}
+	if !bytes.HasPrefix(data[start:], []byte("xref\n")) {
+		t.Fatalf("startxref (%d) xref tablosunu göstermiyor", start)
+	}
+	lines := strings.Split(string(data[start:]), "\n")
+	var first, count int
+	if _, err := fmt.Sscanf(lines[1], "%d %d", &first, &count); err != nil || first != 0 {
+		t.Fatalf("xref alt bölüm başlığı okunamadı: %q", lines[1])
+	}
+	if !strings.Contains(string(data), fmt.Sprintf("/Size %d ", count)) {
+		t.Errorf("trailer /Size %d değil", count)
+	}
+
+	objects := make(map[int]pdfObject, count)
+	for ref := 1; ref < count; ref++ {
+		entry := lines[2+ref]
+		if len(entry) != 19 || !strings.HasSuffix(entry, " 00000 n ") {
+			t.Fatalf("xref kaydı %d bozuk: %q", ref, entry)
+		}
+		offset, _ := strconv.Atoi(entry[:10])
+		header := fmt.Sprintf("%d 0 obj\n", ref)
+		if !bytes.HasPrefix(data[offset:], []byte(header)) {
+			t.Fatalf("nesne %d: xref konumu %d, orada %q var", ref, offset, data[offset:min(offset+20, len(data))])
+		}
+		body := data[offset+len(header):]
+		body = body[:bytes.Index(body, []byte("\nendobj\n"))+1]
+		object := pdfObject{dict: string(body)}
+		if i := bytes.Index(body, []byte(">>\nstream\n")); i >= 0 {
+			object.dict = string(body[:i+2])
+			length, _ := strconv.Atoi(lengthPattern.FindStringSubmatch(object.dict)[1])
+			compressed := body[i+len(">>\nstream\n"):][:length]
+			zr, err := zlib.NewReader(bytes.NewReader(compressed))
+			if err != nil {
+				t.Fatalf("nesne %d akışı açılamadı: %v", ref, err)
+			}
+			object.stream, err = io.ReadAll(zr)
+			if err != nil {
+				t.Fatalf("nesne %d akışı okunamadı: %v", ref, err)
+			}
+		}
+		objects[ref] = object
+	}
+	return objects
+}
+
+func refIn(t *testing.T, dict, key string) int {
+	t.Helper()
+	m := regexp.MustCompile(key + ` \[?` + refPattern).FindStringSubmatch(dict)
+	if m == nil {
+		t.Fatalf("%s başvurusu bulunamadı: %s", key, dict)
+	}
+	ref, _ := strconv.Atoi(m[1])
+	return ref
+}
+
+func TestWriteToXrefOffsets(t *testing.T) {
+	data := buildSampleDocument(t)
+	if !bytes.HasPrefix(data, []byte("%PDF-1.7\n")) || !bytes.HasSuffix(data, []byte("%%EOF\n")) {
+		t.Fatal("PDF başlığı ya da sonu eksik")
+	}
+	objects := readObjects(t, data)
+	pages := 0
+	for _, object := range objects {
+		if strings.Contains(object.dict, "/Type /Page ") {
+			pages++
+		}
+	}
+	if pages < 2 {
+		t.Fatalf("%d sayfa, tablonun taşması için en az 2 bekleniyordu", pages)
+	}
+}
+
+func TestWriteToEmbedsUsedGlyphs(t *testing.T) {
+	objects := readObjects(t, buildSampleDocument(t))
+
+	// Sayfalarda her yazı tipiyle gösterilen glifler ve metinler
+	used := map[string]map[uint16]bool{}
+	var shown []struct {
+		font   string
+		glyphs []uint16
+	}
+	fonts := map[string]int{}
+	for _, object := range objects {
+		if !strings.Contains(object.dict, "/Type /Page ") {
+			continue
+		}
+		for _, m := range fontRefPattern.FindAllStringSubmatch(object.dict, -1) {
+			ref, _ := strconv.Atoi(m[2])
+			fonts[m[1]] = ref
+		}
+		content := objects[refIn(t, object.dict, "/Contents")].stream
+		for _, m := range showPattern.FindAllStringSubmatch(string(content), -1) {
+			if used[m[1]] == nil {
+				used[m[1]] = map[uint16]bool{}
+			}
+			var glyphs []uint16
+			for i := 0; i+4 <= len(m[2]); i += 4 {
+				g, _ := strconv.ParseUint(m[2][i:i+4], 16, 16)
+				used[m[1]][uint16(g)] = true
+				glyphs = append(glyphs, uint16(g))
+			}
+			shown = append(shown, struct {
+				font   string
+				glyphs []uint16
+			}{m[1], glyphs})
+		}
+	}
+	if len(fonts) != 2 {
+		t.Fatalf("%d yazı tipi kaynağı, normal ve kalın bekleniyordu", len(fonts))
+	}
+
+	texts := map[string]bool{}
+	for name, ref := range fonts {
+		type0 := objects[ref].dict
+		if !strings.Contains(type0, "/Subtype /Type0") || !strings.Contains(type0, "/Encoding /Identity-H") {
+			t.Fatalf("F%s Type0/Identity-H değil: %s", name, type0)
+		}
+		cid := objects[refIn(t, type0, "/DescendantFonts")].dict
+		if !strings.Contains(cid, "/Subtype /CIDFontType2") {
+			t.Fatalf("F%s alt yazı tipi CIDFontType2 değil: %s", name, cid)
+		}
+		descriptor := objects[refIn(t, cid, "/FontDescriptor")].dict
+		if file := objects[refIn(t, descriptor, "/FontFile2")]; len(file.stream) == 0 {
+			t.Fatalf("F%s yazı tipi dosyası gömülmemiş", name)
+		}
+
+		widths := map[uint16]bool{}
+		w := cid[strings.Index(cid, "/W [")+4:]
+		for _, m := range widthPattern.FindAllStringSubmatch(w[:strings.Index(w, "] /CIDToGIDMap")], -1) {
+			g, _ := strconv.Atoi(m[1])
+			widths[uint16(g)] = true
+		}
+
+		cmap := string(objects[refIn(t, type0, "/ToUnicode")].stream)
+		unicode := map[uint16]string{}
+		blocks := blockPattern.FindAllStringSubmatch(cmap, -1)
+		for _, block := range blocks {
+			declared, _ := strconv.Atoi(block[1])
+			entries := bfcharPattern.FindAllStringSubmatch(block[2], -1)
+			if declared != len(entries) || declared > 100 {
+				t.Errorf("F%s bfchar bloğu %d kayıt bildiriyor, %d kayıt içeriyor (en fazla 100)", name, declared, len(entries))
+			}
+			for _, entry := range entries {
+				g, _ := strconv.ParseUint(entry[1], 16, 16)
+				var units []uint16
+				for i := 0; i+4 <= len(entry[2]); i += 4 {
+					u, _ := strconv.ParseUint(entry[2][i:i+4], 16, 16)
+					units = append(units, uint16(u))
+				}
+				unicode[uint16(g)] = string(utf16.Decode(units))
+			}
+		}
+		if name == "1" && len(blocks) < 2 {
+			t.Errorf("normal yazı tipinde %d bfchar bloğu, 100'den fazla glif için en az 2 bekleniyordu", len(blocks))
+		}
+
+		for g := range used[name] {
+			if !widths[g] {
+				t.Errorf("F%s: %04X glifinin /W kaydı yok", name, g)
+			}
+			if _, ok := unicode[g]; !ok {
+				t.Errorf("F%s: %04X glifinin ToUnicode kaydı yok", name, g)
+			}
+		}
+		for _, s := range shown {
+			if s.font != name {
+				continue
+			}
+			var text strings.Builder
+			for _, g := range s.glyphs {
+				text.WriteString(unicode[g])
+			}
+			texts[text.String()] = true
+		}
+	}
+
+	// Türkçe harfler ToUnicode üzerinden aynen geri okunabilmeli
+	for _, want := range []string{turkishSample, "Işıl Gümüşsoy 0", "İğdır", "Şehir"} {
+		if !texts[want] {
+			t.Errorf("%q metni ToUnicode ile geri okunamadı", want)
+		}
+	}
+}
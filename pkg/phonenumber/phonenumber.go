@@ -0,0 +1,52 @@
+package phonenumber
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidPhoneNumber = errors.New("geçersiz telefon numarası")
+
+const turkeyCountryCode = "90"
+
+// Normalize, telefon numarasını E.164 biçimine (+905551112233) çevirir.
+// Ülke kodu olmayan numaralar Türkiye numarası kabul edilir. Yalnızca ASCII rakamlar kabul edilir;
+// başka yazı sistemlerinin rakamları numarayı geçersiz kılar.
+func Normalize(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	international := strings.HasPrefix(raw, "+")
+
+	var digits strings.Builder
+	for _, r := range raw {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case unicode.IsDigit(r):
+			return "", ErrInvalidPhoneNumber
+		}
+	}
+	number := digits.String()
+
+	switch {
+	case international:
+	case strings.HasPrefix(number, "00"):
+		number = strings.TrimPrefix(number, "00")
+	case strings.HasPrefix(number, "0") && len(number) == 11:
+		number = turkeyCountryCode + number[1:]
+	case len(number) == 10 && !strings.HasPrefix(number, "0"):
+		number = turkeyCountryCode + number
+	}
+
+	// Ülke kodları 0 ile başlamaz; hâlâ 0 ile başlayan numara ülke kodu olmayan yabancı bir yerel numaradır
+	if strings.HasPrefix(number, "0") {
+		return "", ErrInvalidPhoneNumber
+	}
+	if strings.HasPrefix(number, turkeyCountryCode) && len(number) != 12 {
+		return "", ErrInvalidPhoneNumber
+	}
+	if len(number) < 10 || len(number) > 15 {
+		return "", ErrInvalidPhoneNumber
+	}
+	return "+" + number, nil
+}
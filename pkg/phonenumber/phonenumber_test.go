@@ -0,0 +1,64 @@
+package phonenumber
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{"TR cep, başında 0", "0555 111 22 33", "+905551112233"},
+		{"TR cep, 0 olmadan", "555-111-22-33", "+905551112233"},
+		{"TR cep, parantezli", "(0555) 111 22 33", "+905551112233"},
+		{"TR sabit hat", "0212 555 66 77", "+902125556677"},
+		{"TR, + ile", "+90 555 111 22 33", "+905551112233"},
+		{"TR, 00 ile", "0090 555 111 22 33", "+905551112233"},
+		{"TR, ülke kodu 0 olmadan", "905551112233", "+905551112233"},
+		{"yabancı, + ile", "+49 151 12345678", "+4915112345678"},
+		{"yabancı, 00 ile", "0049 151 12345678", "+4915112345678"},
+		{"yabancı, + ile kısa", "+44 20 7946 0958", "+442079460958"},
+		{"boşluklarla çevrili", "  05551112233  ", "+905551112233"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.raw)
+			if err != nil {
+				t.Fatalf("Normalize(%q) hata döndürdü: %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Fatalf("Normalize(%q) = %q, %q bekleniyordu", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{"boş", ""},
+		{"yabancı yerel numara, 12 hane", "015112345678"},
+		{"yabancı yerel numara, 10 hane", "0151123456"},
+		{"+ sonrası 0", "+0555 111 22 33"},
+		{"00 sonrası 0", "000555 111 22 33"},
+		{"TR, eksik hane", "0555 111 22 3"},
+		{"TR, fazla hane", "+90 555 111 22 334"},
+		{"çok kısa", "12345"},
+		{"çok uzun", "+1234567890123456"},
+		{"Arap-Hint rakamları", "٠٥٥٥١١١٢٢٣٣"},
+		{"karışık rakamlar", "0555 111 22 ٣٣"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.raw)
+			if !errors.Is(err, ErrInvalidPhoneNumber) {
+				t.Fatalf("Normalize(%q) = %q, %v; ErrInvalidPhoneNumber bekleniyordu", tt.raw, got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,434 @@
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// ISO/IEC 18004 Ek I: "01234567" 1-M sembolünün veri ve hata düzeltme kod sözcükleri
+var (
+	specData = []byte{0x10, 0x20, 0x0C, 0x56, 0x61, 0x80, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11}
+	specECC  = []byte{0xA5, 0x24, 0xD4, 0xC1, 0xED, 0x36, 0xC7, 0x87, 0x2C, 0x55}
+)
+
+func TestReedSolomonKnownAnswers(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want []byte
+	}{
+		{"01234567 1-M", specData, specECC},
+		{"HELLO WORLD 1-M", []byte{0x20, 0x5B, 0x0B, 0x78, 0xD1, 0x72, 0xDC, 0x4D, 0x43, 0x40, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11},
+			[]byte{0xC4, 0x23, 0x27, 0x77, 0xEB, 0xD7, 0xE7, 0xE2, 0x5D, 0x17}},
+	}
+	for _, tt := range tests {
+		got := reedSolomonRemainder(tt.data, reedSolomonDivisor(len(tt.want)))
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: ECC = % X, % X bekleniyordu", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFormatBits(t *testing.T) {
+	// Standarttaki 32 format bilgisi dizisi (maske 0-7)
+	want := map[Level][8]string{
+		Low:      {"111011111000100", "111001011110011", "111110110101010", "111100010011101", "110011000101111", "110001100011000", "110110001000001", "110100101110110"},
+		Medium:   {"101010000010010", "101000100100101", "101111001111100", "101101101001011", "100010111111001", "100000011001110", "100111110010111", "100101010100000"},
+		Quartile: {"011010101011111", "011000001101000", "011111100110001", "011101000000110", "010010010110100", "010000110000011", "010111011011010", "010101111101101"},
+		High:     {"001011010001001", "001001110111110", "001110011100111", "001100111010000", "000011101100010", "000001001010101", "000110100001100", "000100000111011"},
+	}
+	for level, masks := range want {
+		for mask, bits := range masks {
+			if got := formatBitString(formatBits(level, mask)); got != bits {
+				t.Errorf("formatBits(%d, %d) = %s, %s bekleniyordu", level, mask, got, bits)
+			}
+		}
+	}
+}
+
+func TestVersionBits(t *testing.T) {
+	want := map[int]int{7: 0x07C94, 8: 0x085BC, 21: 0x15683, 40: 0x28C69}
+	for version, bits := range want {
+		if got := versionBits(version); got != bits {
+			t.Errorf("versionBits(%d) = %#x, %#x bekleniyordu", version, got, bits)
+		}
+	}
+}
+
+func TestEncodeVersionSelection(t *testing.T) {
+	// Bayt kipi kapasiteleri: bir bayt fazlası bir sonraki sürüme geçer
+	tests := []struct {
+		level    Level
+		capacity int
+		version  int
+	}{
+		{Low, 17, 1},
+		{Medium, 14, 1},
+		{Quartile, 11, 1},
+		{High, 7, 1},
+		{Medium, 26, 2},
+		{Low, 134, 6},
+		{Medium, 213, 10},
+		{High, 119, 10},
+	}
+	for _, tt := range tests {
+		code, err := Encode(strings.Repeat("a", tt.capacity), tt.level)
+		if err != nil || code.Version != tt.version {
+			t.Errorf("%d bayt, seviye %d: sürüm %v (err=%v), %d bekleniyordu", tt.capacity, tt.level, versionOf(code), err, tt.version)
+		}
+		code, err = Encode(strings.Repeat("a", tt.capacity+1), tt.level)
+		if err != nil || code.Version != tt.version+1 {
+			t.Errorf("%d bayt, seviye %d: sürüm %v (err=%v), %d bekleniyordu", tt.capacity+1, tt.level, versionOf(code), err, tt.version+1)
+		}
+	}
+
+	if code, err := Encode(strings.Repeat("a", 2953), Low); err != nil || code.Version != 40 {
+		t.Errorf("2953 bayt: sürüm %v (err=%v), 40 bekleniyordu", versionOf(code), err)
+	}
+	if _, err := Encode(strings.Repeat("a", 2954), Low); !errors.Is(err, ErrDataTooLong) {
+		t.Errorf("2954 bayt: err=%v, ErrDataTooLong bekleniyordu", err)
+	}
+	if code, _ := Encode("x", Level(9)); code == nil || code.Level != Medium {
+		t.Errorf("geçersiz seviye Medium'a düşmeliydi")
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	payloads := []string{
+		"https://davet.link/123asd1",
+		"Düğünümüze davetlisiniz: Ayşe & İsmail, 14.09.2026 Çeşme",
+		strings.Repeat("davet.link ", 14),  // 7. sürüm, sürüm bilgisi çizilir
+		strings.Repeat("ğüşıöçĞÜŞİÖÇ", 20), // 16 bitlik karakter sayısı
+	}
+	for _, payload := range payloads {
+		for level := Low; level <= High; level++ {
+			code, err := Encode(payload, level)
+			if err != nil {
+				t.Fatalf("Encode(%q, %d): %v", payload, level, err)
+			}
+			if got := decode(t, code); got != payload {
+				t.Errorf("sürüm %d seviye %d: çözülen veri %q, %q bekleniyordu", code.Version, level, got, payload)
+			}
+		}
+	}
+}
+
+func TestLinePenalty(t *testing.T) {
+	tests := []struct {
+		line string
+		want int
+	}{
+		{"0101010101", 0},
+		{"1111001010", 0},
+		{"1111101010", penaltyN1},
+		{"1111111010", penaltyN1 + 2},
+		// Bulucu desene benzeyen dizi, sembol dışı açık kabul edilen kenara bitişik
+		{"1011101010", penaltyN3},
+		// İki yanında dört açık modül olan desen her iki yön için ayrı sayılır
+		{"0101000010111010", 2 * penaltyN3},
+		{"0000011111", 2 * penaltyN1},
+	}
+	for _, tt := range tests {
+		line := make([]bool, len(tt.line))
+		for i, ch := range tt.line {
+			line[i] = ch == '1'
+		}
+		if got := linePenalty(line); got != tt.want {
+			t.Errorf("linePenalty(%s) = %d, %d bekleniyordu", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestPenaltyScoreAllDark(t *testing.T) {
+	code := newCode(1, Medium)
+	for y := range code.modules {
+		for x := range code.modules[y] {
+			code.modules[y][x] = true
+		}
+	}
+	// 42 satır/sütun x (3+16) + 20x20 blok x 3 + %100 koyu için 9 x 10
+	want := 42*(penaltyN1+16) + 20*20*penaltyN2 + 9*penaltyN4
+	if got := code.penaltyScore(); got != want {
+		t.Errorf("penaltyScore = %d, %d bekleniyordu", got, want)
+	}
+}
+
+func TestChooseMaskPicksLowestPenalty(t *testing.T) {
+	code, err := Encode("https://davet.link/123asd1", Quartile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chosen := code.mask
+	code.applyMask(chosen) // maskesiz hale döner
+	scores := make([]int, 8)
+	for mask := 0; mask < 8; mask++ {
+		code.applyMask(mask)
+		code.drawFormatBits(mask)
+		scores[mask] = code.penaltyScore()
+		code.applyMask(mask)
+	}
+	for mask, score := range scores {
+		if score < scores[chosen] {
+			t.Errorf("maske %d seçildi (ceza %d), maske %d daha düşük (%d)", chosen, scores[chosen], mask, score)
+		}
+	}
+}
+
+func TestLevelWithLogo(t *testing.T) {
+	tests := []struct {
+		level    Level
+		withLogo bool
+		want     Level
+	}{
+		{Low, true, Quartile},
+		{Medium, true, Quartile},
+		{Quartile, true, Quartile},
+		{High, true, High},
+		{Low, false, Low},
+		{Medium, false, Medium},
+	}
+	for _, tt := range tests {
+		if got := LevelWithLogo(tt.level, tt.withLogo); got != tt.want {
+			t.Errorf("LevelWithLogo(%d, %v) = %d, %d bekleniyordu", tt.level, tt.withLogo, got, tt.want)
+		}
+	}
+}
+
+func TestRenderPNG(t *testing.T) {
+	code, err := Encode("https://davet.link/123asd1", Quartile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logo := image.NewNRGBA(image.Rect(0, 0, 40, 40))
+	for _, opts := range []RenderOptions{{Size: 256}, {Size: 256, Logo: logo}} {
+		data, err := code.PNG(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b := img.Bounds(); b.Dx() != 256 || b.Dy() != 256 {
+			t.Errorf("PNG boyutu %v, 256x256 bekleniyordu", b)
+		}
+	}
+}
+
+// decode, sembolü kodlayıcıdan bağımsız olarak okur: format bilgisinden seviye ve maskeyi bulur, maskeyi kaldırır,
+// blokları ayırıp Reed-Solomon sendromlarını denetler ve bayt kipindeki veriyi döndürür.
+func decode(t *testing.T, c *Code) string {
+	t.Helper()
+	bit := func(x, y int) int {
+		if c.Dark(x, y) {
+			return 1
+		}
+		return 0
+	}
+
+	first, second := 0, 0
+	for i := 0; i <= 5; i++ {
+		first |= bit(8, i) << i
+	}
+	first |= bit(8, 7)<<6 | bit(8, 8)<<7 | bit(7, 8)<<8
+	for i := 9; i < 15; i++ {
+		first |= bit(14-i, 8) << i
+	}
+	for i := 0; i < 8; i++ {
+		second |= bit(c.Size-1-i, 8) << i
+	}
+	for i := 8; i < 15; i++ {
+		second |= bit(8, c.Size-15+i) << i
+	}
+	if first != second {
+		t.Fatalf("format bilgisinin iki kopyası farklı: %015b / %015b", first, second)
+	}
+	level, mask := Level(-1), -1
+	for l := Low; l <= High; l++ {
+		for m := 0; m < 8; m++ {
+			if formatBits(l, m) == first {
+				level, mask = l, m
+			}
+		}
+	}
+	if level != c.Level || mask < 0 {
+		t.Fatalf("format bilgisi %015b çözülemedi (seviye %d)", first, level)
+	}
+	if bit(8, c.Size-8) != 1 {
+		t.Fatalf("koyu modül eksik")
+	}
+
+	if c.Version >= 7 {
+		for _, corner := range [2]bool{false, true} {
+			bits := 0
+			for i := 0; i < 18; i++ {
+				a, b := c.Size-11+i%3, i/3
+				if corner {
+					a, b = b, a
+				}
+				bits |= bit(a, b) << i
+			}
+			if bits != versionBits(c.Version) {
+				t.Fatalf("sürüm bilgisi %#x, %#x bekleniyordu", bits, versionBits(c.Version))
+			}
+		}
+	}
+
+	reference := newCode(c.Version, c.Level)
+	reference.drawFunctionPatterns()
+	masked := func(x, y int) bool {
+		switch mask {
+		case 0:
+			return (y+x)%2 == 0
+		case 1:
+			return y%2 == 0
+		case 2:
+			return x%3 == 0
+		case 3:
+			return (y+x)%3 == 0
+		case 4:
+			return (y/2+x/3)%2 == 0
+		case 5:
+			return (y*x)%2+(y*x)%3 == 0
+		case 6:
+			return ((y*x)%2+(y*x)%3)%2 == 0
+		default:
+			return ((y+x)%2+(y*x)%3)%2 == 0
+		}
+	}
+
+	rawCodewords := numRawDataModules(c.Version) / 8
+	codewords := make([]byte, rawCodewords)
+	n := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < c.Size; vert++ {
+			y := vert
+			if (right+1)&2 == 0 {
+				y = c.Size - 1 - vert
+			}
+			for x := right; x >= right-1; x-- {
+				if reference.isFunction[y][x] || n >= rawCodewords*8 {
+					continue
+				}
+				dark := c.Dark(x, y) != masked(x, y)
+				if dark {
+					codewords[n>>3] |= 1 << (7 - uint(n&7))
+				}
+				n++
+			}
+		}
+	}
+
+	numBlocks := numErrorCorrectionBlocks[c.Level][c.Version]
+	eccLen := eccCodewordsPerBlock[c.Level][c.Version]
+	numShort := numBlocks - rawCodewords%numBlocks
+	shortData := rawCodewords/numBlocks - eccLen
+	blocks := make([][]byte, numBlocks)
+	k := 0
+	for i := 0; i <= shortData; i++ {
+		for b := range blocks {
+			if i < shortData || b >= numShort {
+				blocks[b] = append(blocks[b], codewords[k])
+				k++
+			}
+		}
+	}
+	for i := 0; i < eccLen; i++ {
+		for b := range blocks {
+			blocks[b] = append(blocks[b], codewords[k])
+			k++
+		}
+	}
+
+	var data []byte
+	for b, block := range blocks {
+		for i := 0; i < eccLen; i++ {
+			if s := syndrome(block, i); s != 0 {
+				t.Fatalf("blok %d: %d. sendrom %#x, 0 bekleniyordu", b, i, s)
+			}
+		}
+		data = append(data, block[:len(block)-eccLen]...)
+	}
+
+	reader := bitReader{data: data}
+	if m := reader.read(4); m != 0x4 {
+		t.Fatalf("kip %04b, bayt kipi bekleniyordu", m)
+	}
+	countBits := 8
+	if c.Version >= 10 {
+		countBits = 16
+	}
+	payload := make([]byte, reader.read(countBits))
+	for i := range payload {
+		payload[i] = byte(reader.read(8))
+	}
+	return string(payload)
+}
+
+// syndrome, kod sözcüğü polinomunun alfa^i noktasındaki değeridir; hatasız blokta sıfırdır.
+// Çarpım, kodlayıcıdaki gfMultiply yerine logaritma tablolarıyla yapılır.
+func syndrome(block []byte, i int) byte {
+	exp, log := gfTables()
+	point := exp[i%255]
+	var value byte
+	for _, coefficient := range block {
+		if value != 0 {
+			value = exp[(int(log[value])+int(log[point]))%255]
+		}
+		value ^= coefficient
+	}
+	return value
+}
+
+func gfTables() (exp [256]byte, log [256]byte) {
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(x)
+		log[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11D
+		}
+	}
+	return exp, log
+}
+
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *bitReader) read(n int) int {
+	value := 0
+	for i := 0; i < n; i++ {
+		value = value<<1 | int(r.data[r.pos>>3]>>(7-uint(r.pos&7))&1)
+		r.pos++
+	}
+	return value
+}
+
+func formatBitString(bits int) string {
+	var sb strings.Builder
+	for i := 14; i >= 0; i-- {
+		if bitAt(bits, i) {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+func versionOf(code *Code) interface{} {
+	if code == nil {
+		return nil
+	}
+	return code.Version
+}
@@ -0,0 +1,146 @@
+package qrcode
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+
+	"davet.link/pkg/imageutil"
+)
+
+const (
+	defaultQuietZone = 4
+	// Ortadaki logo, kodun en fazla bu oranını kaplar
+	logoMaxWidthRatio  = 0.30
+	logoMaxHeightRatio = 0.22
+	// SVG'ye gömülen logonun modül başına piksel çözünürlüğü
+	svgLogoPixelsPerModule = 8
+)
+
+type RenderOptions struct {
+	// Çıktının piksel cinsinden kenar uzunluğu
+	Size int
+	// Kenar boşluğu (modül); 0 ise standart 4 modül kullanılır
+	QuietZone int
+	// Ortaya yerleştirilecek isteğe bağlı logo
+	Logo image.Image
+}
+
+func (o RenderOptions) quietZone() int {
+	if o.QuietZone <= 0 {
+		return defaultQuietZone
+	}
+	return o.QuietZone
+}
+
+// Image, kodu beyaz zemin üzerine siyah modüllerle çizer.
+func (c *Code) Image(opts RenderOptions) image.Image {
+	quiet := opts.quietZone()
+	total := c.Size + 2*quiet
+	scale := opts.Size / total
+	if scale < 1 {
+		scale = 1
+	}
+	side := max(opts.Size, total*scale)
+	offset := (side-total*scale)/2 + quiet*scale
+
+	img := image.NewNRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.modules[y][x] {
+				rect := image.Rect(offset+x*scale, offset+y*scale, offset+(x+1)*scale, offset+(y+1)*scale)
+				draw.Draw(img, rect, image.Black, image.Point{}, draw.Src)
+			}
+		}
+	}
+
+	if opts.Logo != nil {
+		codeSide := c.Size * scale
+		logo := imageutil.Fit(opts.Logo,
+			int(float64(codeSide)*logoMaxWidthRatio),
+			int(float64(codeSide)*logoMaxHeightRatio))
+		lb := logo.Bounds()
+		center := offset + codeSide/2
+		logoRect := image.Rect(center-lb.Dx()/2, center-lb.Dy()/2, center-lb.Dx()/2+lb.Dx(), center-lb.Dy()/2+lb.Dy())
+		draw.Draw(img, logoRect.Inset(-scale), image.White, image.Point{}, draw.Src)
+		draw.Draw(img, logoRect, logo, lb.Min, draw.Over)
+	}
+	return img
+}
+
+func (c *Code) PNG(opts RenderOptions) ([]byte, error) {
+	img := c.Image(opts)
+	var buf bytes.Buffer
+	var err error
+	if opts.Logo == nil {
+		err = png.Encode(&buf, toPaletted(img))
+	} else {
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SVG, ölçeklenebilir çıktı üretir; yatay koyu modül dizileri tek yol parçasıyla çizilir.
+func (c *Code) SVG(opts RenderOptions) ([]byte, error) {
+	quiet := opts.quietZone()
+	total := c.Size + 2*quiet
+	side := opts.Size
+	if side <= 0 {
+		side = total * 8
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<?xml version="1.0" encoding="UTF-8"?>`+"\n")
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+"\n", side, side, total, total)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#ffffff"/>`+"\n", total, total)
+	buf.WriteString(`<path fill="#000000" d="`)
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; {
+			if !c.modules[y][x] {
+				x++
+				continue
+			}
+			run := 1
+			for x+run < c.Size && c.modules[y][x+run] {
+				run++
+			}
+			fmt.Fprintf(&buf, "M%d %dh%dv1h-%dz", x+quiet, y+quiet, run, run)
+			x += run
+		}
+	}
+	buf.WriteString(`"/>` + "\n")
+
+	if opts.Logo != nil {
+		logo := imageutil.Fit(opts.Logo,
+			int(float64(c.Size)*logoMaxWidthRatio*svgLogoPixelsPerModule),
+			int(float64(c.Size)*logoMaxHeightRatio*svgLogoPixelsPerModule))
+		var logoPNG bytes.Buffer
+		if err := png.Encode(&logoPNG, logo); err != nil {
+			return nil, err
+		}
+		w := float64(logo.Bounds().Dx()) / svgLogoPixelsPerModule
+		h := float64(logo.Bounds().Dy()) / svgLogoPixelsPerModule
+		x := float64(total)/2 - w/2
+		y := float64(total)/2 - h/2
+		fmt.Fprintf(&buf, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="#ffffff"/>`+"\n", x-1, y-1, w+2, h+2)
+		fmt.Fprintf(&buf, `<image x="%.2f" y="%.2f" width="%.2f" height="%.2f" href="data:image/png;base64,%s"/>`+"\n",
+			x, y, w, h, base64.StdEncoding.EncodeToString(logoPNG.Bytes()))
+	}
+	buf.WriteString("</svg>\n")
+	return buf.Bytes(), nil
+}
+
+func toPaletted(img image.Image) *image.Paletted {
+	bounds := img.Bounds()
+	paletted := image.NewPaletted(bounds, color.Palette{color.White, color.Black})
+	draw.Draw(paletted, bounds, img, bounds.Min, draw.Src)
+	return paletted
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"davet.link/pkg/flashmessages"
+	"davet.link/pkg/i18n"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/log"
@@ -15,12 +16,14 @@ const (
 	FlashSuccessKeyView = "Success"
 	FlashErrorKeyView   = "Error"
 	FormDataKey         = "FormData"
+	LocaleKeyView       = "Locale"
 )
 
 func prepareRenderData(c *fiber.Ctx, data fiber.Map) fiber.Map {
 	renderData := make(fiber.Map)
 
 	renderData[CsrfTokenKey] = c.Locals("csrf")
+	renderData[LocaleKeyView] = i18n.FromCtx(c)
 
 	flashData, flashErr := flashmessages.GetFlashMessages(c)
 	if flashErr != nil {
@@ -35,7 +38,7 @@ func prepareRenderData(c *fiber.Ctx, data fiber.Map) fiber.Map {
 
 	if errVal, ok := data[FlashErrorKeyView]; ok {
 		if errStr, okStr := errVal.(string); okStr {
-			handlerError = errStr
+			handlerError = i18n.Translate(c, errStr)
 		}
 	}
 
@@ -0,0 +1,106 @@
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+// Protokolün tek dosya için izin verdiği en fazla adres sayısı
+const MaxURLsPerSitemap = 50000
+
+const namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+type URL struct {
+	Loc        string
+	LastMod    time.Time
+	ChangeFreq string
+	// 0 ise yazılmaz
+	Priority float64
+}
+
+type xmlURL struct {
+	Loc        string `xml:"loc"`
+	LastMod    string `xml:"lastmod,omitempty"`
+	ChangeFreq string `xml:"changefreq,omitempty"`
+	Priority   string `xml:"priority,omitempty"`
+}
+
+type xmlURLSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	URLs    []xmlURL `xml:"url"`
+}
+
+type xmlSitemap struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type xmlIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	Sitemaps []xmlSitemap `xml:"sitemap"`
+}
+
+// Sitemap, bir site haritası dizinindeki tek bir alt haritadır.
+type Sitemap struct {
+	Loc     string
+	LastMod time.Time
+}
+
+// EncodeURLSet, adresleri <urlset> belgesi olarak yazar.
+func EncodeURLSet(urls []URL) ([]byte, error) {
+	set := xmlURLSet{Xmlns: namespace, URLs: make([]xmlURL, 0, len(urls))}
+	for _, u := range urls {
+		item := xmlURL{
+			Loc:        u.Loc,
+			LastMod:    formatTime(u.LastMod),
+			ChangeFreq: u.ChangeFreq,
+		}
+		if u.Priority > 0 {
+			item.Priority = formatPriority(u.Priority)
+		}
+		set.URLs = append(set.URLs, item)
+	}
+	return encode(set)
+}
+
+// EncodeIndex, alt haritaları <sitemapindex> belgesi olarak yazar.
+func EncodeIndex(sitemaps []Sitemap) ([]byte, error) {
+	index := xmlIndex{Xmlns: namespace, Sitemaps: make([]xmlSitemap, 0, len(sitemaps))}
+	for _, s := range sitemaps {
+		index.Sitemaps = append(index.Sitemaps, xmlSitemap{
+			Loc:     s.Loc,
+			LastMod: formatTime(s.LastMod),
+		})
+	}
+	return encode(index)
+}
+
+func encode(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatPriority(p float64) string {
+	if p > 1 {
+		p = 1
+	}
+	return strconv.FormatFloat(p, 'f', 1, 64)
+}
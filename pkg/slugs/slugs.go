@@ -0,0 +1,67 @@
+package slugs
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Davetiye anahtarı, kartvizit ve sayfa adreslerinin ortak biçimi (ör: 123asd1, ali-ayse, dijital_davetiye)
+var pattern = regexp.MustCompile(`^[A-Za-z0-9]+(?:[-_][A-Za-z0-9]+)*$`)
+
+// Rotalardan ve public klasöründen bağımsız olarak her zaman ayrılan adlar
+var defaultReserved = []string{
+	"auth", "dashboard", "panel", "admin", "api",
+	"uploads", "public", "static", "assets",
+	"calendar", "og", "sitemaps", "sitemap.xml", "robots.txt", "favicon.ico",
+	"login", "logout", "register", "www", "davetlink", "tema-onizleme",
+}
+
+var (
+	mu       sync.RWMutex
+	reserved = make(map[string]struct{})
+)
+
+func init() {
+	Reserve(defaultReserved...)
+}
+
+// Valid, adresin tek bir yol parçası olarak kullanılabilir olup olmadığını döndürür.
+func Valid(slug string) bool {
+	return pattern.MatchString(slug)
+}
+
+// Reserve, verilen adları davetiye, kartvizit ve sayfa adresi olarak kullanıma kapatır.
+func Reserve(words ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, word := range words {
+		word = strings.ToLower(strings.Trim(strings.TrimSpace(word), "/"))
+		if word != "" {
+			reserved[word] = struct{}{}
+		}
+	}
+}
+
+// ReserveDir, statik olarak sunulan klasörün ilk seviyedeki dosya ve klasör adlarını ayırır.
+func ReserveDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	Reserve(names...)
+	return nil
+}
+
+// IsReserved, büyük/küçük harf ayrımı yapmadan adın ayrılmış olup olmadığını döndürür.
+func IsReserved(slug string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	_, ok := reserved[strings.ToLower(slug)]
+	return ok
+}
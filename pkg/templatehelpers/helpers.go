@@ -4,6 +4,11 @@ import (
 	"net/url"
 	"text/template"
 	"time"
+
+	"davet.link/configs/envconfig"
+	"davet.link/pkg/eventtime"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/themes"
 )
 
 func TemplateHelpers() template.FuncMap {
@@ -55,26 +60,72 @@ func TemplateHelpers() template.FuncMap {
 			if t.IsZero() {
 				return ""
 			}
-			return t.Format(layout)
+			return t.In(envconfig.GetLocation()).Format(layout)
 		},
 
 		"FormatDate": func(t time.Time) string {
 			if t.IsZero() {
 				return ""
 			}
-			return t.Format("02.01.2006")
+			return t.In(envconfig.GetLocation()).Format("02.01.2006")
 		},
 
 		"FormatDateTime": func(t time.Time) string {
 			if t.IsZero() {
 				return ""
 			}
-			return t.Format("02.01.2006 15:04")
+			return t.In(envconfig.GetLocation()).Format("02.01.2006 15:04")
+		},
+
+		// Davetiyenin kendi saat diliminde gösterim: {{FormatDateTimeIn .StartsAt .TimeZone}}
+		"FormatTimeIn": func(t time.Time, zone, layout string) string {
+			if t.IsZero() {
+				return ""
+			}
+			return t.In(zoneLocation(zone)).Format(layout)
+		},
+
+		"FormatDateIn": func(t time.Time, zone string) string {
+			if t.IsZero() {
+				return ""
+			}
+			return t.In(zoneLocation(zone)).Format("02.01.2006")
 		},
 
+		"FormatDateTimeIn": func(t time.Time, zone string) string {
+			if t.IsZero() {
+				return ""
+			}
+			return t.In(zoneLocation(zone)).Format("02.01.2006 15:04")
+		},
+
+		// Saat dilimi kısaltması veya UTC farkı (ör: "+03", "CET")
+		"ZoneLabel": func(t time.Time, zone string) string {
+			if t.IsZero() {
+				return ""
+			}
+			return t.In(zoneLocation(zone)).Format("MST")
+		},
+
+		"timeZones": func() []string { return eventtime.CommonZones },
+
 		"hasPrefix": func(s, prefix string) bool {
 			return len(s) >= len(prefix) && s[:len(prefix)] == prefix
 		},
+
+		// Kullanım: {{t .Locale "Kaydet"}}
+		"t":       i18n.T,
+		"locales": i18n.Options,
+
+		"categoryTemplates": func() []string { return themes.Categories },
 	}
 	return fm
 }
+
+// zoneLocation, geçersiz veya boş saat dilimi adlarında uygulamanın saat dilimine düşer.
+func zoneLocation(zone string) *time.Location {
+	if loc, err := eventtime.LoadLocation(zone); err == nil {
+		return loc
+	}
+	return envconfig.GetLocation()
+}
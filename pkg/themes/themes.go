@@ -0,0 +1,275 @@
+package themes
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	// Davetiyede tema seçilmemişse ya da seçilen tema kategoriyi desteklemiyorsa kullanılır
+	DefaultName = "classic"
+	// Kategorinin şablonu tanımlı değilse kullanılan görünüm
+	DefaultCategory = "title"
+
+	categoryViewDir = "website/invitations"
+	fontsBaseURL    = "https://fonts.googleapis.com/css2"
+)
+
+// Categories, InvitationCategory.Template alanının alabileceği değerlerdir.
+// Her biri views/website/invitations altında aynı adlı bir görünüme karşılık gelir.
+var Categories = []string{"title", "person", "person-family", "wedding"}
+
+// ColorVariables, temaların tanımlayabileceği renklerdir; invitation.css içinde --theme-<ad> olarak kullanılır.
+var ColorVariables = []string{"background", "surface", "text", "accent", "overlay"}
+
+var (
+	namePattern  = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	colorPattern = regexp.MustCompile(`^(#[0-9a-fA-F]{3,8}|rgba?\([0-9., ]+\))$`)
+	// Google Fonts biçimi (ör: Playfair Display:wght@400;700)
+	fontPattern = regexp.MustCompile(`^[A-Za-z0-9 ]+(:[a-z]+@[0-9;.,]+)?$`)
+)
+
+type Fonts struct {
+	Body    string `json:"body"`
+	Heading string `json:"heading"`
+	Script  string `json:"script"`
+}
+
+type Theme struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	// Temanın kullanılabileceği kategori şablonları
+	Categories []string          `json:"categories"`
+	Colors     map[string]string `json:"colors"`
+	Fonts      Fonts             `json:"fonts"`
+	// public klasörüne göre önizleme görselinin adresi (ör: /themes/classic.svg)
+	Preview string `json:"preview"`
+}
+
+//go:embed definitions/*.json
+var definitionFiles embed.FS
+
+var registry []Theme
+
+func init() {
+	files, err := fs.Glob(definitionFiles, "definitions/*.json")
+	if err != nil {
+		panic(fmt.Sprintf("themes: tanımlar listelenemedi: %v", err))
+	}
+	for _, file := range files {
+		data, err := definitionFiles.ReadFile(file)
+		if err != nil {
+			panic(fmt.Sprintf("themes: %s okunamadı: %v", file, err))
+		}
+		var theme Theme
+		if err := json.Unmarshal(data, &theme); err != nil {
+			panic(fmt.Sprintf("themes: %s çözümlenemedi: %v", file, err))
+		}
+		registry = append(registry, theme)
+	}
+	// Varsayılan tema listelerde her zaman ilk sırada gösterilir
+	sort.SliceStable(registry, func(i, j int) bool {
+		if registry[i].Name == DefaultName || registry[j].Name == DefaultName {
+			return registry[i].Name == DefaultName
+		}
+		return registry[i].Name < registry[j].Name
+	})
+}
+
+// All, kayıtlı tüm temaları döndürür.
+func All() []Theme {
+	return slices.Clone(registry)
+}
+
+// Get, adı verilen temayı döndürür.
+func Get(name string) (Theme, bool) {
+	for _, theme := range registry {
+		if theme.Name == name {
+			return theme, true
+		}
+	}
+	return Theme{}, false
+}
+
+// ForCategory, kategori şablonunu destekleyen temaları döndürür.
+func ForCategory(category string) []Theme {
+	var themes []Theme
+	for _, theme := range registry {
+		if theme.Supports(category) {
+			themes = append(themes, theme)
+		}
+	}
+	return themes
+}
+
+// Resolve, davetiyede kayıtlı temayı döndürür; tema yoksa ya da kategoriyi desteklemiyorsa varsayılan tema kullanılır.
+func Resolve(name, category string) Theme {
+	if theme, ok := Get(name); ok && theme.Supports(category) {
+		return theme
+	}
+	theme, _ := Get(DefaultName)
+	return theme
+}
+
+// IsCategory, değerin tanımlı bir kategori şablonu olup olmadığını bildirir.
+func IsCategory(category string) bool {
+	return slices.Contains(Categories, category)
+}
+
+// CategoryView, kategori şablonunun görünüm adını döndürür; tanımsız şablonlarda varsayılan görünüm kullanılır.
+func CategoryView(category string) string {
+	if !IsCategory(category) {
+		category = DefaultCategory
+	}
+	return path.Join(categoryViewDir, category)
+}
+
+func (t Theme) Supports(category string) bool {
+	if !IsCategory(category) {
+		category = DefaultCategory
+	}
+	return slices.Contains(t.Categories, category)
+}
+
+// CSS, temanın renk ve yazı tipi değişkenlerini :root içine yazılacak biçimde döndürür.
+// Değerler Validate ile denetlendiğinden kaçışsız eklenebilir.
+func (t Theme) CSS() template.CSS {
+	var b strings.Builder
+	for _, name := range ColorVariables {
+		if value, ok := t.Colors[name]; ok {
+			fmt.Fprintf(&b, "--theme-%s: %s; ", name, value)
+		}
+	}
+	for _, font := range [][2]string{{"body", t.Fonts.Body}, {"heading", t.Fonts.Heading}, {"script", t.Fonts.Script}} {
+		if family := fontFamily(font[1]); family != "" {
+			fmt.Fprintf(&b, "--theme-font-%s: '%s'; ", font[0], family)
+		}
+	}
+	return template.CSS(strings.TrimSpace(b.String()))
+}
+
+type Swatch struct {
+	Name  string
+	Style template.CSS
+}
+
+// Swatches, tema seçicide renk örneklerini göstermek için renkleri sırasıyla döndürür.
+func (t Theme) Swatches() []Swatch {
+	var swatches []Swatch
+	for _, name := range ColorVariables {
+		if value, ok := t.Colors[name]; ok {
+			swatches = append(swatches, Swatch{Name: name, Style: template.CSS("background: " + value)})
+		}
+	}
+	return swatches
+}
+
+// FontsURL, temanın yazı tiplerini tek istekte yükleyen Google Fonts adresini döndürür.
+func (t Theme) FontsURL() string {
+	var families []string
+	for _, spec := range []string{t.Fonts.Body, t.Fonts.Heading, t.Fonts.Script} {
+		family := "family=" + strings.ReplaceAll(spec, " ", "+")
+		if spec != "" && !slices.Contains(families, family) {
+			families = append(families, family)
+		}
+	}
+	if len(families) == 0 {
+		return ""
+	}
+	return fontsBaseURL + "?" + strings.Join(families, "&") + "&display=swap"
+}
+
+// Validate, kayıtlı temaları ve kategori şablonlarını views ve public klasörlerine göre denetler.
+// Uygulama açılırken çağrılır; hatalı bir tema sayfalar sunulmadan önce fark edilir.
+func Validate(viewsDir, publicDir string) error {
+	var errs []error
+	for _, category := range Categories {
+		if err := fileExists(filepath.Join(viewsDir, filepath.FromSlash(CategoryView(category))+".html")); err != nil {
+			errs = append(errs, fmt.Errorf("%s kategori şablonu: %w", category, err))
+		}
+	}
+
+	seen := make(map[string]bool, len(registry))
+	for _, theme := range registry {
+		if !namePattern.MatchString(theme.Name) {
+			errs = append(errs, fmt.Errorf("geçersiz tema adı: %q", theme.Name))
+			continue
+		}
+		if seen[theme.Name] {
+			errs = append(errs, fmt.Errorf("%s teması birden fazla kez tanımlanmış", theme.Name))
+		}
+		seen[theme.Name] = true
+		if theme.Label == "" {
+			errs = append(errs, fmt.Errorf("%s teması: görünen ad boş", theme.Name))
+		}
+		if len(theme.Categories) == 0 {
+			errs = append(errs, fmt.Errorf("%s teması: desteklenen kategori yok", theme.Name))
+		}
+		for _, category := range theme.Categories {
+			if !IsCategory(category) {
+				errs = append(errs, fmt.Errorf("%s teması: bilinmeyen kategori şablonu %q", theme.Name, category))
+			}
+		}
+		for name, value := range theme.Colors {
+			if !slices.Contains(ColorVariables, name) {
+				errs = append(errs, fmt.Errorf("%s teması: bilinmeyen renk değişkeni %q", theme.Name, name))
+			}
+			if !colorPattern.MatchString(value) {
+				errs = append(errs, fmt.Errorf("%s teması: geçersiz renk %s=%q", theme.Name, name, value))
+			}
+		}
+		for _, spec := range []string{theme.Fonts.Body, theme.Fonts.Heading, theme.Fonts.Script} {
+			if spec != "" && !fontPattern.MatchString(spec) {
+				errs = append(errs, fmt.Errorf("%s teması: geçersiz yazı tipi %q", theme.Name, spec))
+			}
+		}
+		if theme.Preview == "" {
+			errs = append(errs, fmt.Errorf("%s teması: önizleme görseli tanımlı değil", theme.Name))
+		} else if err := fileExists(filepath.Join(publicDir, filepath.FromSlash(path.Clean("/"+theme.Preview)))); err != nil {
+			errs = append(errs, fmt.Errorf("%s teması önizleme görseli: %w", theme.Name, err))
+		}
+	}
+
+	if !seen[DefaultName] {
+		errs = append(errs, fmt.Errorf("varsayılan tema (%s) tanımlı değil", DefaultName))
+	} else if theme, _ := Get(DefaultName); !supportsAll(theme) {
+		errs = append(errs, fmt.Errorf("varsayılan tema (%s) tüm kategori şablonlarını desteklemeli", DefaultName))
+	}
+	return errors.Join(errs...)
+}
+
+func supportsAll(theme Theme) bool {
+	for _, category := range Categories {
+		if !slices.Contains(theme.Categories, category) {
+			return false
+		}
+	}
+	return true
+}
+
+func fontFamily(spec string) string {
+	family, _, _ := strings.Cut(spec, ":")
+	return strings.TrimSpace(family)
+}
+
+func fileExists(name string) error {
+	info, err := os.Stat(name)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("%s bir klasör", name)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package vcard
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"mime/quotedprintable"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+var ErrInvalidVCard = errors.New("geçersiz vCard dosyası")
+
+// Decode, telefon rehberinden dışa aktarılan vCard (2.1, 3.0, 4.0) dosyasındaki kişileri okur.
+// Yalnızca ad, telefon ve e-posta alınır; telefon olarak varsa cep numarası, yoksa ilk numara kullanılır.
+func Decode(r io.Reader) ([]Card, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var cards []Card
+	var current *Card
+	var structuredName string
+	mobile := false
+	found := false
+	for _, line := range lines {
+		name, params, value, ok := splitProperty(line)
+		if !ok {
+			continue
+		}
+		switch name {
+		case "BEGIN":
+			if strings.EqualFold(value, "VCARD") {
+				found = true
+				current = &Card{}
+				structuredName = ""
+				mobile = false
+			}
+			continue
+		case "END":
+			if current != nil && strings.EqualFold(value, "VCARD") {
+				if current.FullName == "" {
+					current.FullName = structuredName
+				}
+				if current.FullName != "" || current.Telephone != "" {
+					cards = append(cards, *current)
+				}
+			}
+			current = nil
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		value = decodeValue(value, params)
+		switch name {
+		case "FN":
+			current.FullName = strings.Join(strings.Fields(unescapeText(value)), " ")
+		case "N":
+			structuredName = joinStructuredName(value)
+		case "TEL":
+			tel := strings.TrimSpace(strings.TrimPrefix(value, "tel:"))
+			if tel == "" {
+				continue
+			}
+			isMobile := params.hasType("cell") || params.hasType("mobile")
+			if current.Telephone == "" || (isMobile && !mobile) {
+				current.Telephone = tel
+				mobile = isMobile
+			}
+		case "EMAIL":
+			if current.Email == "" {
+				current.Email = strings.TrimSpace(unescapeText(value))
+			}
+		}
+	}
+	if !found {
+		return nil, ErrInvalidVCard
+	}
+	return cards, nil
+}
+
+// unfold, katlanmış satırları (boşluk veya sekmeyle başlayan) ve vCard 2.1'deki
+// quoted-printable yumuşak satır sonlarını (= ile biten) birleştirir.
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) == 0 {
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+		last := len(lines) - 1
+		switch {
+		case last >= 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")):
+			lines[last] += line[1:]
+		case last >= 0 && strings.HasSuffix(lines[last], "=") && isQuotedPrintable(lines[last]):
+			lines[last] = strings.TrimSuffix(lines[last], "=") + line
+		case strings.TrimSpace(line) != "":
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, ErrInvalidVCard
+	}
+	return lines, nil
+}
+
+func isQuotedPrintable(line string) bool {
+	colon := strings.IndexByte(line, ':')
+	return colon > 0 && strings.Contains(strings.ToUpper(line[:colon]), "QUOTED-PRINTABLE")
+}
+
+type properties map[string][]string
+
+func (p properties) hasType(t string) bool {
+	for _, value := range p["TYPE"] {
+		if strings.EqualFold(value, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitProperty, "item1.TEL;TYPE=CELL:+90..." satırını özellik adı, parametreler ve değer olarak ayırır.
+// vCard 2.1'deki adsız parametreler (TEL;CELL) TYPE olarak kabul edilir.
+func splitProperty(line string) (string, properties, string, bool) {
+	colon := -1
+	quoted := false
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon <= 0 {
+		return "", nil, "", false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	name := strings.ToUpper(parts[0])
+	if dot := strings.LastIndexByte(name, '.'); dot >= 0 {
+		name = name[dot+1:]
+	}
+	params := properties{}
+	for _, part := range parts[1:] {
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			key, value = "TYPE", part
+		}
+		key = strings.ToUpper(strings.TrimSpace(key))
+		for _, v := range strings.Split(strings.Trim(value, `"`), ",") {
+			params[key] = append(params[key], strings.TrimSpace(v))
+		}
+	}
+	return name, params, line[colon+1:], true
+}
+
+// decodeValue, quoted-printable kodlamasını ve Türkçe karakter kümelerini UTF-8'e çevirir.
+func decodeValue(value string, params properties) string {
+	for _, encoding := range params["ENCODING"] {
+		if strings.EqualFold(encoding, "QUOTED-PRINTABLE") {
+			if decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(value))); err == nil {
+				value = string(decoded)
+			}
+		}
+	}
+	for _, charset := range params["CHARSET"] {
+		switch strings.ToUpper(charset) {
+		case "WINDOWS-1254", "CP1254", "ISO-8859-9":
+			if decoded, err := charmap.Windows1254.NewDecoder().String(value); err == nil {
+				value = decoded
+			}
+		}
+	}
+	return value
+}
+
+// joinStructuredName, N özelliğini (soyad;ad;ikinci ad;ön ek;son ek) okunma sırasıyla birleştirir.
+func joinStructuredName(value string) string {
+	fields := splitEscaped(value, ';')
+	for len(fields) < 5 {
+		fields = append(fields, "")
+	}
+	ordered := []string{fields[3], fields[1], fields[2], fields[0], fields[4]}
+	var parts []string
+	for _, field := range ordered {
+		if field = strings.TrimSpace(unescapeText(field)); field != "" {
+			parts = append(parts, field)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// splitEscaped, değeri kaçış karakteri (\) ile yazılmamış ayraçlardan böler.
+func splitEscaped(value string, sep byte) []string {
+	var fields []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case sep:
+			fields = append(fields, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(fields, value[start:])
+}
+
+func unescapeText(s string) string {
+	replacer := strings.NewReplacer(
+		`\\`, `\`,
+		`\,`, ",",
+		`\;`, ";",
+		`\n`, " ",
+		`\N`, " ",
+	)
+	return replacer.Replace(s)
+}
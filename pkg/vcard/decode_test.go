@@ -0,0 +1,81 @@
+package vcard
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []Card
+	}{
+		{
+			name: "vCard 3.0, cep numarası öncelikli",
+			data: "BEGIN:VCARD\r\nVERSION:3.0\r\nFN:Ayşe Yılmaz\r\nTEL;TYPE=HOME:+90 212 555 00 00\r\nTEL;TYPE=CELL,VOICE:+90 532 123 45 67\r\nEMAIL;TYPE=INTERNET:ayse@example.com\r\nEND:VCARD\r\n",
+			want: []Card{{FullName: "Ayşe Yılmaz", Telephone: "+90 532 123 45 67", Email: "ayse@example.com"}},
+		},
+		{
+			name: "vCard 4.0, tel URI ve gruplu özellik",
+			data: "BEGIN:VCARD\nVERSION:4.0\nFN:Mehmet Öz\nitem1.TEL;VALUE=uri;TYPE=\"cell,voice\":tel:+49-151-2345678\nEND:VCARD\n",
+			want: []Card{{FullName: "Mehmet Öz", Telephone: "+49-151-2345678"}},
+		},
+		{
+			name: "vCard 2.1, adsız parametre ve quoted-printable UTF-8",
+			data: "BEGIN:VCARD\r\nVERSION:2.1\r\nN;CHARSET=UTF-8;ENCODING=QUOTED-PRINTABLE:=C3=87a=C4=9Flayan;=C5=9E=C3=BCkr=C3=BC;;;\r\nTEL;WORK:0212 444 44 44\r\nTEL;CELL:0533 765 43 21\r\nEND:VCARD\r\n",
+			want: []Card{{FullName: "Şükrü Çağlayan", Telephone: "0533 765 43 21"}},
+		},
+		{
+			name: "vCard 2.1, Windows-1254 ve yumuşak satır sonu",
+			data: "BEGIN:VCARD\r\nVERSION:2.1\r\nFN;CHARSET=WINDOWS-1254;ENCODING=QUOTED-PRINTABLE:=DDlknur =\r\nI=FE=FDk\r\nTEL:05001112233\r\nEND:VCARD\r\n",
+			want: []Card{{FullName: "İlknur Işık", Telephone: "05001112233"}},
+		},
+		{
+			name: "katlanmış satır, kaçışlı değer ve BOM",
+			data: "\uFEFFBEGIN:VCARD\r\nVERSION:3.0\r\nN:Demir;Ali\\, Veli;;Dr.;\r\nNOTE:uzun bir\r\n  not\r\nEMAIL:ali@exam\r\n ple.com\r\nEND:VCARD\r\n",
+			want: []Card{{FullName: "Dr. Ali, Veli Demir", Email: "ali@example.com"}},
+		},
+		{
+			name: "birden çok kart, adı ve telefonu olmayan atlanır",
+			data: "BEGIN:VCARD\nFN:Bir\nEND:VCARD\nBEGIN:VCARD\nEMAIL:yalniz@example.com\nEND:VCARD\nBEGIN:VCARD\nTEL:+905321234567\nEND:VCARD\n",
+			want: []Card{{FullName: "Bir"}, {Telephone: "+905321234567"}},
+		},
+		{
+			name: "kart dışındaki satırlar yok sayılır",
+			data: "FN:Dışarıda\nBEGIN:VCARD\nFN:İçeride\nEND:VCARD\nTEL:123\n",
+			want: []Card{{FullName: "İçeride"}},
+		},
+	}
+	for _, tt := range tests {
+		cards, err := Decode(strings.NewReader(tt.data))
+		if err != nil {
+			t.Errorf("%s: hata = %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(cards, tt.want) {
+			t.Errorf("%s: kartlar = %+v, %+v bekleniyordu", tt.name, cards, tt.want)
+		}
+	}
+}
+
+func TestDecodeRejects(t *testing.T) {
+	for _, data := range []string{"", "FN:Ayşe\nTEL:123\n", "Ad;Telefon\nAyşe;0532\n"} {
+		if _, err := Decode(strings.NewReader(data)); !errors.Is(err, ErrInvalidVCard) {
+			t.Errorf("Decode(%q) hata = %v, ErrInvalidVCard bekleniyordu", data, err)
+		}
+	}
+}
+
+func TestDecodeEncodeRoundTrip(t *testing.T) {
+	card := Card{FullName: "Gülşen Çelik", Telephone: "+905321234567", Email: "gulsen@example.com"}
+	cards, err := Decode(strings.NewReader(string(card.Encode())))
+	if err != nil {
+		t.Fatalf("hata = %v", err)
+	}
+	if len(cards) != 1 || cards[0].FullName != card.FullName || cards[0].Telephone != card.Telephone || cards[0].Email != card.Email {
+		t.Errorf("kartlar = %+v, %+v bekleniyordu", cards, card)
+	}
+}
@@ -0,0 +1,153 @@
+package vcard
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"davet.link/pkg/contentline"
+)
+
+type SocialProfile struct {
+	Type string
+	URL  string
+}
+
+// Card, vCard 4.0 olarak yazılacak kişi bilgilerini taşır.
+type Card struct {
+	UID       string
+	FullName  string
+	Title     string
+	PhotoURL  string
+	Telephone string
+	Email     string
+	Website   string
+	Location  string
+	SourceURL string
+	Social    []SocialProfile
+	Revision  time.Time
+}
+
+func (c Card) Encode() []byte {
+	var b strings.Builder
+	write := func(line string) {
+		b.WriteString(contentline.Fold(line))
+		b.WriteString(contentline.LineEnding)
+	}
+
+	write("BEGIN:VCARD")
+	write("VERSION:4.0")
+	write("PRODID:-//davet.link//vCard//TR")
+	if c.UID != "" {
+		write("UID:" + c.UID)
+	}
+	write("KIND:individual")
+
+	fullName := strings.TrimSpace(c.FullName)
+	write("FN:" + escapeText(fullName))
+	family, given := splitName(fullName)
+	write("N:" + escapeText(family) + ";" + escapeText(given) + ";;;")
+
+	if c.Title != "" {
+		write("TITLE:" + escapeText(c.Title))
+	}
+	if c.PhotoURL != "" {
+		write("PHOTO:" + c.PhotoURL)
+	}
+	if tel := telURI(c.Telephone); tel != "" {
+		write(`TEL;VALUE=uri;TYPE="voice,cell":` + tel)
+	}
+	if c.Email != "" {
+		write("EMAIL;TYPE=work:" + escapeText(c.Email))
+	}
+	if c.Website != "" {
+		write("URL;TYPE=work:" + c.Website)
+	}
+	if c.Location != "" {
+		write(`ADR;TYPE=work;LABEL="` + escapeParam(c.Location) + `":;;` + escapeText(c.Location) + ";;;;")
+	}
+	for _, profile := range c.Social {
+		if profile.URL == "" {
+			continue
+		}
+		prop := "X-SOCIALPROFILE"
+		if t := paramToken(profile.Type); t != "" {
+			prop += ";TYPE=" + t
+		}
+		write(prop + ":" + profile.URL)
+	}
+	if c.SourceURL != "" {
+		write("SOURCE:" + c.SourceURL)
+	}
+	if !c.Revision.IsZero() {
+		write("REV:" + c.Revision.UTC().Format("20060102T150405Z"))
+	}
+	write("END:VCARD")
+
+	return []byte(b.String())
+}
+
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		",", `\,`,
+		";", `\;`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(s)
+}
+
+// Parametre değerleri tırnak içinde yazıldığından çift tırnak ve satır sonları kaldırılır.
+func escapeParam(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '"', '\r', '\n':
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+func paramToken(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if b.Len() > 0 && !dash {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimRight(b.String(), "-")
+}
+
+func telURI(phone string) string {
+	var b strings.Builder
+	for i, r := range strings.TrimSpace(phone) {
+		if unicode.IsDigit(r) || (r == '+' && i == 0) {
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() == 0 || b.String() == "+" {
+		return ""
+	}
+	return "tel:" + b.String()
+}
+
+func splitName(fullName string) (family, given string) {
+	fields := strings.Fields(fullName)
+	switch len(fields) {
+	case 0:
+		return "", ""
+	case 1:
+		return "", fields[0]
+	}
+	return fields[len(fields)-1], strings.Join(fields[:len(fields)-1], " ")
+}
@@ -17,6 +17,8 @@ const userIDKey = "user_id"
 var (
 	ErrNotFound      = errors.New("kayıt bulunamadı")
 	ErrMissingUserID = errors.New("context içinde geçerli user_id yok")
+	// ErrCapacityExceeded, katılım bildirimi davetiyenin kontenjanını aştığında döner
+	ErrCapacityExceeded = errors.New("kontenjan aşıldı")
 )
 
 type IBaseRepository[T any] interface {
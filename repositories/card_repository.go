@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"errors"
 
 	"davet.link/configs/databaseconfig"
 	"davet.link/models"
@@ -13,6 +14,7 @@ import (
 type ICardRepository interface {
 	GetAllCards(params queryparams.ListParams) ([]models.Card, int64, error)
 	GetCardByID(id uint) (*models.Card, error)
+	GetActiveCardBySlug(slug string) (*models.Card, error)
 	CreateCard(ctx context.Context, card *models.Card) error
 	BulkCreateCards(ctx context.Context, cards []models.Card) error
 	UpdateCard(ctx context.Context, id uint, data map[string]interface{}, updatedBy uint) error
@@ -21,6 +23,9 @@ type ICardRepository interface {
 	BulkDeleteCards(ctx context.Context, condition map[string]interface{}) error
 	GetCardCount() (int64, error)
 	GetAllCardsByUserID(userID uint, params queryparams.ListParams) ([]models.Card, int64, error)
+	GetActiveCardCount() (int64, error)
+	GetActiveCardsForSitemap(offset, limit int) ([]models.Card, error)
+	CardSlugExists(slug string, exceptID uint) (bool, error)
 }
 
 type CardRepository struct {
@@ -50,6 +55,22 @@ func (r *CardRepository) GetCardByID(id uint) (*models.Card, error) {
 	return r.base.GetByID(id)
 }
 
+func (r *CardRepository) GetActiveCardBySlug(slug string) (*models.Card, error) {
+	var card models.Card
+	err := r.db.
+		Preload("CardBanks.Bank").
+		Preload("CardSocialMedia.SocialMedia").
+		Where("slug = ? AND is_active = ?", slug, true).
+		First(&card).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
+
 func (r *CardRepository) CreateCard(ctx context.Context, card *models.Card) error {
 	return r.base.Create(ctx, card)
 }
@@ -89,5 +110,33 @@ func (r *CardRepository) GetAllCardsByUserID(userID uint, params queryparams.Lis
 	return cards, totalCount, db.Error
 }
 
+func (r *CardRepository) GetActiveCardCount() (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Card{}).Where("is_active = ?", true).Count(&count).Error
+	return count, err
+}
+
+// Site haritası için yalnızca slug ve güncellenme zamanı okunur
+func (r *CardRepository) GetActiveCardsForSitemap(offset, limit int) ([]models.Card, error) {
+	var cards []models.Card
+	err := r.db.
+		Select("id", "slug", "updated_at").
+		Where("is_active = ?", true).
+		Order("id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&cards).Error
+	return cards, err
+}
+
+// Silinmiş kayıtlar da benzersiz indeksi tuttuğu için sorguya dahil edilir
+func (r *CardRepository) CardSlugExists(slug string, exceptID uint) (bool, error) {
+	var count int64
+	err := r.db.Unscoped().Model(&models.Card{}).
+		Where("LOWER(slug) = LOWER(?) AND id <> ?", slug, exceptID).
+		Count(&count).Error
+	return count > 0, err
+}
+
 var _ ICardRepository = (*CardRepository)(nil)
 var _ IBaseRepository[models.Card] = (*BaseRepository[models.Card])(nil)
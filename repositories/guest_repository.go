@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"davet.link/configs/databaseconfig"
+	"davet.link/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IGuestRepository interface {
+	CreateGuest(ctx context.Context, guest *models.Guest) error
+	GetGuest(invitationID, id uint) (*models.Guest, error)
+	// GetGuestByToken, kişiye özel bağlantıdaki davetliyi katılım bildirimi ve soru yanıtlarıyla birlikte döndürür.
+	GetGuestByToken(invitationID uint, token string) (*models.Guest, error)
+	// GetGuestsByInvitationID, davet listesini ada göre sıralı ve katılım bildirimleriyle birlikte döndürür.
+	GetGuestsByInvitationID(invitationID uint) ([]models.Guest, error)
+	CountGuests(invitationID uint) (int64, error)
+	UpdateGuest(ctx context.Context, id uint, data map[string]interface{}) error
+	// MarkOpened, bağlantının ilk açılış zamanını yazar; daha önce açılmışsa kayıt değişmez.
+	MarkOpened(id uint, openedAt time.Time) error
+	SetParticipant(id, participantID uint) error
+	DeleteGuest(ctx context.Context, id uint) error
+	// ImportGuests, davetiye satırını kilitleyip mevcut davet listesini build'e verir ve
+	// build'in döndürdüğü davetlileri aynı işlem içinde ekler; build hata dönerse hiçbir kayıt eklenmez.
+	ImportGuests(ctx context.Context, invitationID uint, build func(existing []models.Guest) ([]models.Guest, error)) error
+}
+
+type GuestRepository struct {
+	db *gorm.DB
+}
+
+func NewGuestRepository() IGuestRepository {
+	return &GuestRepository{db: databaseconfig.GetDB()}
+}
+
+func (r *GuestRepository) CreateGuest(ctx context.Context, guest *models.Guest) error {
+	return r.db.WithContext(ctx).Create(guest).Error
+}
+
+func (r *GuestRepository) GetGuest(invitationID, id uint) (*models.Guest, error) {
+	var guest models.Guest
+	err := r.db.Where("id = ? AND invitation_id = ?", id, invitationID).First(&guest).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+func (r *GuestRepository) GetGuestByToken(invitationID uint, token string) (*models.Guest, error) {
+	var guest models.Guest
+	err := r.db.Preload("Participant.Answers").Preload("Participant.Companions", orderCompanions).
+		Where("invitation_id = ? AND token = ?", invitationID, token).
+		First(&guest).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+func (r *GuestRepository) GetGuestsByInvitationID(invitationID uint) ([]models.Guest, error) {
+	var guests []models.Guest
+	err := r.db.Preload("Participant").
+		Where("invitation_id = ?", invitationID).
+		Order("name ASC, id ASC").
+		Find(&guests).Error
+	return guests, err
+}
+
+func (r *GuestRepository) CountGuests(invitationID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Guest{}).Where("invitation_id = ?", invitationID).Count(&count).Error
+	return count, err
+}
+
+func (r *GuestRepository) UpdateGuest(ctx context.Context, id uint, data map[string]interface{}) error {
+	result := r.db.WithContext(ctx).Model(&models.Guest{}).Where("id = ?", id).Updates(data)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *GuestRepository) MarkOpened(id uint, openedAt time.Time) error {
+	return r.db.Model(&models.Guest{}).
+		Where("id = ? AND opened_at IS NULL", id).
+		UpdateColumn("opened_at", openedAt).Error
+}
+
+func (r *GuestRepository) SetParticipant(id, participantID uint) error {
+	return r.db.Model(&models.Guest{}).Where("id = ?", id).UpdateColumn("participant_id", participantID).Error
+}
+
+func (r *GuestRepository) DeleteGuest(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.Guest{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *GuestRepository) ImportGuests(ctx context.Context, invitationID uint, build func(existing []models.Guest) ([]models.Guest, error)) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Aynı davetiyeye eşzamanlı içe aktarmalar birbirinin tekrar denetimini atlamasın diye davetiye satırı kilitlenir
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			First(&models.Invitation{}, invitationID).Error; err != nil {
+			return err
+		}
+
+		var existing []models.Guest
+		if err := tx.Where("invitation_id = ?", invitationID).Find(&existing).Error; err != nil {
+			return err
+		}
+		guests, err := build(existing)
+		if err != nil {
+			return err
+		}
+		if len(guests) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(&guests, 200).Error
+	})
+}
+
+var _ IGuestRepository = (*GuestRepository)(nil)
@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"davet.link/configs/databaseconfig"
+	"davet.link/models"
+
+	"gorm.io/gorm"
+)
+
+type GuestbookStatusCount struct {
+	Status models.GuestbookStatus
+	Count  int64
+}
+
+type IGuestbookRepository interface {
+	CreateEntry(entry *models.GuestbookEntry) error
+	GetEntry(invitationID, id uint) (*models.GuestbookEntry, error)
+	// GetEntries, mesajları en yeniden eskiye döndürür; durum boşsa tüm mesajlar gelir, limit 0 ise sınır uygulanmaz.
+	GetEntries(invitationID uint, status models.GuestbookStatus, limit int) ([]models.GuestbookEntry, error)
+	GetStatusCounts(invitationID uint) ([]GuestbookStatusCount, error)
+	UpdateEntryStatus(ctx context.Context, id uint, status models.GuestbookStatus) error
+	DeleteEntry(ctx context.Context, id uint) error
+}
+
+type GuestbookRepository struct {
+	db *gorm.DB
+}
+
+func NewGuestbookRepository() IGuestbookRepository {
+	return &GuestbookRepository{db: databaseconfig.GetDB()}
+}
+
+func (r *GuestbookRepository) CreateEntry(entry *models.GuestbookEntry) error {
+	return r.db.Create(entry).Error
+}
+
+func (r *GuestbookRepository) GetEntry(invitationID, id uint) (*models.GuestbookEntry, error) {
+	var entry models.GuestbookEntry
+	err := r.db.Where("id = ? AND invitation_id = ?", id, invitationID).First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *GuestbookRepository) GetEntries(invitationID uint, status models.GuestbookStatus, limit int) ([]models.GuestbookEntry, error) {
+	var entries []models.GuestbookEntry
+	query := r.db.Where("invitation_id = ?", invitationID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Order("created_at DESC").Find(&entries).Error
+	return entries, err
+}
+
+func (r *GuestbookRepository) GetStatusCounts(invitationID uint) ([]GuestbookStatusCount, error) {
+	var counts []GuestbookStatusCount
+	err := r.db.Model(&models.GuestbookEntry{}).
+		Select("status, COUNT(*) AS count").
+		Where("invitation_id = ?", invitationID).
+		Group("status").
+		Scan(&counts).Error
+	return counts, err
+}
+
+func (r *GuestbookRepository) UpdateEntryStatus(ctx context.Context, id uint, status models.GuestbookStatus) error {
+	result := r.db.WithContext(ctx).Model(&models.GuestbookEntry{}).Where("id = ?", id).Update("status", status)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *GuestbookRepository) DeleteEntry(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.GuestbookEntry{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+var _ IGuestbookRepository = (*GuestbookRepository)(nil)
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"davet.link/configs/databaseconfig"
+	"davet.link/models"
+
+	"gorm.io/gorm"
+)
+
+type IInvitationEventRepository interface {
+	CreateEvent(ctx context.Context, event *models.InvitationEvent) error
+	GetEvent(invitationID, id uint) (*models.InvitationEvent, error)
+	// GetEventsByInvitationID, programı başlangıç saatine göre sıralı döndürür.
+	GetEventsByInvitationID(invitationID uint) ([]models.InvitationEvent, error)
+	// GetEventsByInvitationIDs, birden çok davetiyenin programını davetiyeye göre gruplar.
+	GetEventsByInvitationIDs(invitationIDs []uint) (map[uint][]models.InvitationEvent, error)
+	CountEvents(invitationID uint) (int64, error)
+	UpdateEvent(ctx context.Context, id uint, data map[string]interface{}) error
+	DeleteEvent(ctx context.Context, id uint) error
+}
+
+type InvitationEventRepository struct {
+	db *gorm.DB
+}
+
+func NewInvitationEventRepository() IInvitationEventRepository {
+	return &InvitationEventRepository{db: databaseconfig.GetDB()}
+}
+
+func (r *InvitationEventRepository) CreateEvent(ctx context.Context, event *models.InvitationEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+func (r *InvitationEventRepository) GetEvent(invitationID, id uint) (*models.InvitationEvent, error) {
+	var event models.InvitationEvent
+	err := r.db.Where("id = ? AND invitation_id = ?", id, invitationID).First(&event).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *InvitationEventRepository) GetEventsByInvitationID(invitationID uint) ([]models.InvitationEvent, error) {
+	var events []models.InvitationEvent
+	err := r.db.Where("invitation_id = ?", invitationID).Order("starts_at ASC, id ASC").Find(&events).Error
+	return events, err
+}
+
+func (r *InvitationEventRepository) GetEventsByInvitationIDs(invitationIDs []uint) (map[uint][]models.InvitationEvent, error) {
+	grouped := make(map[uint][]models.InvitationEvent)
+	if len(invitationIDs) == 0 {
+		return grouped, nil
+	}
+	var events []models.InvitationEvent
+	err := r.db.Where("invitation_id IN ?", invitationIDs).Order("starts_at ASC, id ASC").Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		grouped[event.InvitationID] = append(grouped[event.InvitationID], event)
+	}
+	return grouped, nil
+}
+
+func (r *InvitationEventRepository) CountEvents(invitationID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.InvitationEvent{}).Where("invitation_id = ?", invitationID).Count(&count).Error
+	return count, err
+}
+
+func (r *InvitationEventRepository) UpdateEvent(ctx context.Context, id uint, data map[string]interface{}) error {
+	result := r.db.WithContext(ctx).Model(&models.InvitationEvent{}).Where("id = ?", id).Updates(data)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *InvitationEventRepository) DeleteEvent(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.InvitationEvent{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+var _ IInvitationEventRepository = (*InvitationEventRepository)(nil)
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"davet.link/configs/databaseconfig"
+	"davet.link/models"
+
+	"gorm.io/gorm"
+)
+
+type IInvitationMediaRepository interface {
+	CreateMedia(ctx context.Context, media *models.InvitationMedia) error
+	GetMedia(invitationID, id uint) (*models.InvitationMedia, error)
+	// GetMediaByInvitationID, galeriyi sıralama değerine göre döndürür.
+	GetMediaByInvitationID(invitationID uint) ([]models.InvitationMedia, error)
+	CountMedia(invitationID uint) (int64, error)
+	// NextSortOrder, galerinin sonuna eklenecek fotoğrafın sıralama değerini döndürür.
+	NextSortOrder(invitationID uint) (int, error)
+	UpdateCaption(ctx context.Context, id uint, caption string) error
+	// SwapSortOrder, iki fotoğrafın yerini tek işlemde değiştirir.
+	SwapSortOrder(ctx context.Context, first, second *models.InvitationMedia) error
+	DeleteMedia(ctx context.Context, id uint) error
+}
+
+type InvitationMediaRepository struct {
+	db *gorm.DB
+}
+
+func NewInvitationMediaRepository() IInvitationMediaRepository {
+	return &InvitationMediaRepository{db: databaseconfig.GetDB()}
+}
+
+func (r *InvitationMediaRepository) CreateMedia(ctx context.Context, media *models.InvitationMedia) error {
+	return r.db.WithContext(ctx).Create(media).Error
+}
+
+func (r *InvitationMediaRepository) GetMedia(invitationID, id uint) (*models.InvitationMedia, error) {
+	var media models.InvitationMedia
+	err := r.db.Where("id = ? AND invitation_id = ?", id, invitationID).First(&media).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &media, nil
+}
+
+func (r *InvitationMediaRepository) GetMediaByInvitationID(invitationID uint) ([]models.InvitationMedia, error) {
+	var media []models.InvitationMedia
+	err := r.db.Where("invitation_id = ?", invitationID).Order("sort_order ASC, id ASC").Find(&media).Error
+	return media, err
+}
+
+func (r *InvitationMediaRepository) CountMedia(invitationID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.InvitationMedia{}).Where("invitation_id = ?", invitationID).Count(&count).Error
+	return count, err
+}
+
+func (r *InvitationMediaRepository) NextSortOrder(invitationID uint) (int, error) {
+	var maxOrder *int
+	err := r.db.Model(&models.InvitationMedia{}).
+		Select("MAX(sort_order)").
+		Where("invitation_id = ?", invitationID).
+		Scan(&maxOrder).Error
+	if err != nil || maxOrder == nil {
+		return 0, err
+	}
+	return *maxOrder + 1, nil
+}
+
+func (r *InvitationMediaRepository) UpdateCaption(ctx context.Context, id uint, caption string) error {
+	result := r.db.WithContext(ctx).Model(&models.InvitationMedia{}).Where("id = ?", id).Update("caption", caption)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *InvitationMediaRepository) SwapSortOrder(ctx context.Context, first, second *models.InvitationMedia) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.InvitationMedia{}).Where("id = ?", first.ID).Update("sort_order", second.SortOrder).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.InvitationMedia{}).Where("id = ?", second.ID).Update("sort_order", first.SortOrder).Error
+	})
+}
+
+func (r *InvitationMediaRepository) DeleteMedia(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.InvitationMedia{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+var _ IInvitationMediaRepository = (*InvitationMediaRepository)(nil)
@@ -2,17 +2,23 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"davet.link/configs/databaseconfig"
 	"davet.link/models"
 	"davet.link/pkg/queryparams"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type IInvitationRepository interface {
 	GetAllInvitations(params queryparams.ListParams) ([]models.Invitation, int64, error)
 	GetInvitationByID(id uint) (*models.Invitation, error)
+	GetConfirmedInvitationByKey(key string) (*models.Invitation, error)
+	GetInvitationsByUserID(userID uint) ([]models.Invitation, error)
 	CreateInvitation(ctx context.Context, invitation *models.Invitation) error
 	UpdateInvitation(ctx context.Context, id uint, data map[string]interface{}, updatedBy uint) error
 	DeleteInvitation(ctx context.Context, id uint) error
@@ -20,6 +26,16 @@ type IInvitationRepository interface {
 	GetParticipantsByInvitationID(invitationID uint) ([]models.InvitationParticipant, error)
 	UpdateParticipant(id uint, participant *models.InvitationParticipant) error
 	DeleteParticipant(id uint) error
+	SaveParticipantByPhone(participant *models.InvitationParticipant) (bool, error)
+	// PromoteWaitlisted, kontenjanda açılan yerleri bekleme listesindekilere sırayla verir.
+	PromoteWaitlisted(ctx context.Context, invitationID uint) error
+	// GetAttendingHeadcount, katılacağını bildirenlerin toplam kişi sayısını döndürür.
+	GetAttendingHeadcount(invitationID uint) (int, error)
+	// GetParticipantResponses, davetiyedeki yanıt geçmişini en yeni kayıt başta olacak şekilde döndürür.
+	GetParticipantResponses(invitationID uint) ([]models.ParticipantResponse, error)
+	GetPublicInvitationCount() (int64, error)
+	GetPublicInvitationsForSitemap(offset, limit int) ([]models.Invitation, error)
+	InvitationKeyExists(key string, exceptID uint) (bool, error)
 }
 
 type InvitationRepository struct {
@@ -35,6 +51,7 @@ func NewInvitationRepository() IInvitationRepository {
 		"Category",
 		"InvitationDetail",
 		"Participants",
+		"Translations",
 	)
 	return &InvitationRepository{base: base, db: databaseconfig.GetDB()}
 }
@@ -47,6 +64,34 @@ func (r *InvitationRepository) GetInvitationByID(id uint) (*models.Invitation, e
 	return r.base.GetByID(id)
 }
 
+func (r *InvitationRepository) GetConfirmedInvitationByKey(key string) (*models.Invitation, error) {
+	var invitation models.Invitation
+	err := r.db.
+		Preload("User").
+		Preload("Category").
+		Preload("InvitationDetail").
+		Preload("Translations").
+		Where("invitation_key = ? AND is_confirmed = ?", key, true).
+		First(&invitation).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+func (r *InvitationRepository) GetInvitationsByUserID(userID uint) ([]models.Invitation, error) {
+	var invitations []models.Invitation
+	err := r.db.
+		Preload("InvitationDetail").
+		Where("user_id = ?", userID).
+		Order("starts_at ASC").
+		Find(&invitations).Error
+	return invitations, err
+}
+
 func (r *InvitationRepository) CreateInvitation(ctx context.Context, invitation *models.Invitation) error {
 	return r.base.Create(ctx, invitation)
 }
@@ -65,17 +110,363 @@ func (r *InvitationRepository) GetInvitationCount() (int64, error) {
 
 func (r *InvitationRepository) GetParticipantsByInvitationID(invitationID uint) ([]models.InvitationParticipant, error) {
 	var participants []models.InvitationParticipant
-	err := r.db.Where("invitation_id = ?", invitationID).Find(&participants).Error
+	err := r.db.Preload("Answers").Preload("Companions", orderCompanions).
+		Where("invitation_id = ?", invitationID).
+		Find(&participants).Error
 	return participants, err
 }
 
+// Yanıt veya kişi sayısı değiştiyse yanıt geçmişine kayıt eklenir.
+// Kişi sayısı azaldıysa boşalan yer aynı işlem içinde bekleme listesindekilere verilir.
 func (r *InvitationRepository) UpdateParticipant(id uint, participant *models.InvitationParticipant) error {
-	return r.db.Model(&models.InvitationParticipant{}).Where("id = ?", id).Updates(participant).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return withCapacityLock(tx, id, func(previous models.InvitationParticipant) error {
+			if err := tx.Model(&models.InvitationParticipant{}).Where("id = ?", id).Updates(participant).Error; err != nil {
+				return err
+			}
+			// Updates sıfır değerleri yazmadığından boş bırakılan alanlar önceki değerini korur
+			status, guestCount := previous.Status, previous.GuestCount
+			if participant.Status != "" {
+				status = participant.Status
+			}
+			if participant.GuestCount != 0 {
+				guestCount = participant.GuestCount
+			}
+			if status == previous.Status && guestCount == previous.GuestCount {
+				return nil
+			}
+			return recordResponse(tx, previous.InvitationID, id, status, guestCount, time.Now())
+		})
+	})
 }
 
+// Silme yanıt geçmişine kaydedilir; boşalan yer aynı işlem içinde bekleme listesindekilere verilir.
 func (r *InvitationRepository) DeleteParticipant(id uint) error {
-	return r.db.Delete(&models.InvitationParticipant{}, id).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return withCapacityLock(tx, id, func(previous models.InvitationParticipant) error {
+			if err := tx.Delete(&models.InvitationParticipant{}, id).Error; err != nil {
+				return err
+			}
+			return recordResponse(tx, previous.InvitationID, id, models.RSVPRemoved, 0, time.Now())
+		})
+	})
+}
+
+func (r *InvitationRepository) GetPublicInvitationCount() (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Invitation{}).
+		Where("is_confirmed = ? AND is_public = ?", true, true).
+		Count(&count).Error
+	return count, err
+}
+
+// Site haritası için yalnızca anahtar ve güncellenme zamanı okunur
+func (r *InvitationRepository) GetPublicInvitationsForSitemap(offset, limit int) ([]models.Invitation, error) {
+	var invitations []models.Invitation
+	err := r.db.
+		Select("id", "invitation_key", "updated_at").
+		Where("is_confirmed = ? AND is_public = ?", true, true).
+		Order("id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&invitations).Error
+	return invitations, err
+}
+
+// Silinmiş kayıtlar da benzersiz indeksi tuttuğu için sorguya dahil edilir
+func (r *InvitationRepository) InvitationKeyExists(key string, exceptID uint) (bool, error) {
+	var count int64
+	err := r.db.Unscoped().Model(&models.Invitation{}).
+		Where("LOWER(invitation_key) = LOWER(?) AND id <> ?", key, exceptID).
+		Count(&count).Error
+	return count > 0, err
 }
 
 var _ IInvitationRepository = (*InvitationRepository)(nil)
 var _ IBaseRepository[models.Invitation] = (*BaseRepository[models.Invitation])(nil)
+
+// Aynı telefonla daha önce bildirim yapılmışsa kaydı günceller; yeni kayıt oluşturulduysa true döner.
+// Yeni bildirimde ya da yanıt veya kişi sayısı değiştiğinde aynı işlem içinde yanıt geçmişine kayıt eklenir.
+// Katılım sorularının yanıtları (participant.Answers) ve refakatçiler (participant.Companions) her bildirimde baştan yazılır.
+// Davetiyede kontenjan varsa yere sığmayan yeni katılım bildirimi bekleme listesine alınır (Status waitlisted olur);
+// yeri ayrılmış bir katılımcının kişi sayısını kontenjanın üzerine çıkarması ErrCapacityExceeded döndürür.
+func (r *InvitationRepository) SaveParticipantByPhone(participant *models.InvitationParticipant) (bool, error) {
+	created := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Aynı davetiyeye eşzamanlı bildirimleri sıraya sokmak için davetiye satırı kilitlenir;
+		// kontenjan hesabı da bu kilit altında yapılır
+		capacity, err := lockCapacity(tx, participant.InvitationID)
+		if err != nil {
+			return err
+		}
+
+		var existing *models.InvitationParticipant
+		var found models.InvitationParticipant
+		err = tx.Where("invitation_id = ? AND phone_number = ?", participant.InvitationID, participant.PhoneNumber).
+			First(&found).Error
+		switch {
+		case err == nil:
+			existing = &found
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return err
+		}
+
+		if err := assignSeat(tx, capacity, participant, existing); err != nil {
+			return err
+		}
+
+		changed := true
+		if existing == nil {
+			created = true
+			if err := tx.Omit("Answers", "Companions").Create(participant).Error; err != nil {
+				return err
+			}
+		} else {
+			participant.ID = existing.ID
+			changed = existing.Status != participant.Status || existing.GuestCount != participant.GuestCount
+			err := tx.Model(existing).Updates(map[string]interface{}{
+				"title":         participant.Title,
+				"guest_count":   participant.GuestCount,
+				"status":        participant.Status,
+				"responded_at":  participant.RespondedAt,
+				"waitlisted_at": participant.WaitlistedAt,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if err := saveAnswers(tx, participant); err != nil {
+			return err
+		}
+		if err := saveCompanions(tx, participant); err != nil {
+			return err
+		}
+
+		if changed {
+			err := recordResponse(tx, participant.InvitationID, participant.ID, participant.Status, participant.GuestCount, participant.RespondedAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Katılımdan vazgeçen ya da kişi sayısını azaltan davetlinin boşalttığı yer sıradakilere verilir
+		return promoteWaitlisted(tx, participant.InvitationID, capacity, participant.RespondedAt)
+	})
+	return created, err
+}
+
+func (r *InvitationRepository) PromoteWaitlisted(ctx context.Context, invitationID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		capacity, err := lockCapacity(tx, invitationID)
+		if err != nil {
+			return err
+		}
+		return promoteWaitlisted(tx, invitationID, capacity, time.Now())
+	})
+}
+
+func (r *InvitationRepository) GetAttendingHeadcount(invitationID uint) (int, error) {
+	return attendingHeadcount(r.db, invitationID, 0)
+}
+
+// lockCapacity, davetiye satırını işlem sonuna kadar kilitleyip kontenjanı (0: sınırsız) döndürür.
+func lockCapacity(tx *gorm.DB, invitationID uint) (int, error) {
+	var invitation models.Invitation
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "max_headcount").
+		First(&invitation, invitationID).Error
+	return invitation.MaxHeadcount, err
+}
+
+// withCapacityLock, katılımcının davetiyesini kilitleyip change'i katılımcının değişiklikten önceki
+// yanıtı ve kişi sayısıyla çalıştırır; ardından açılan yerleri bekleme listesindekilere dağıtır.
+// Katılımcı bulunamazsa hiçbir şey yapmaz.
+func withCapacityLock(tx *gorm.DB, participantID uint, change func(previous models.InvitationParticipant) error) error {
+	var participant models.InvitationParticipant
+	err := tx.Select("id", "invitation_id").First(&participant, participantID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	capacity, err := lockCapacity(tx, participant.InvitationID)
+	if err != nil {
+		return err
+	}
+	// Kilit alınmadan okunan değerler eşzamanlı bir bildirimle değişmiş olabilir
+	if err := tx.Select("id", "invitation_id", "status", "guest_count").First(&participant, participantID).Error; err != nil {
+		return err
+	}
+	if err := change(participant); err != nil {
+		return err
+	}
+	return promoteWaitlisted(tx, participant.InvitationID, capacity, time.Now())
+}
+
+// recordResponse, yanıt geçmişine kayıt ekler.
+func recordResponse(tx *gorm.DB, invitationID, participantID uint, status models.RSVPStatus, guestCount int, at time.Time) error {
+	return tx.Create(&models.ParticipantResponse{
+		InvitationID:  invitationID,
+		ParticipantID: participantID,
+		Status:        status,
+		GuestCount:    guestCount,
+		CreatedAt:     at,
+	}).Error
+}
+
+// attendingHeadcount, katılacağını bildirenlerin toplam kişi sayısını verir; exceptID sayıma katılmaz.
+// "Belki" yanıtı ve bekleme listesi kontenjandan yer tutmaz.
+func attendingHeadcount(tx *gorm.DB, invitationID, exceptID uint) (int, error) {
+	var total int
+	err := tx.Model(&models.InvitationParticipant{}).
+		Select("COALESCE(SUM(guest_count), 0)").
+		Where("invitation_id = ? AND status = ? AND id <> ?", invitationID, models.RSVPAttending, exceptID).
+		Scan(&total).Error
+	return total, err
+}
+
+// assignSeat, katılacağını bildiren davetli kontenjana sığmıyorsa ya da bekleme listesinde sırası gelmemiş
+// davetliler varsa onu bekleme listesine alır; boş yer olsa bile kimse sıranın önüne geçemez.
+// Listeye daha önce girmiş davetli sırasını korur, yer açıldıysa promoteWaitlisted ile sırası geldiğinde alınır.
+// Kontenjan sonradan düşürülmüş olsa bile yeri ayrılmış katılımcı kişi sayısını artırmadığı sürece yerini kaybetmez.
+func assignSeat(tx *gorm.DB, capacity int, participant, existing *models.InvitationParticipant) error {
+	participant.WaitlistedAt = nil
+	if participant.Status != models.RSVPAttending || capacity <= 0 {
+		return nil
+	}
+	seated := existing != nil && existing.Status == models.RSVPAttending
+	if seated && participant.GuestCount <= existing.GuestCount {
+		return nil
+	}
+
+	queued := false
+	if !seated {
+		var waiting int64
+		err := tx.Model(&models.InvitationParticipant{}).
+			Where("invitation_id = ? AND status = ?", participant.InvitationID, models.RSVPWaitlisted).
+			Count(&waiting).Error
+		if err != nil {
+			return err
+		}
+		queued = waiting > 0
+	}
+	if !queued {
+		var exceptID uint
+		if existing != nil {
+			exceptID = existing.ID
+		}
+		taken, err := attendingHeadcount(tx, participant.InvitationID, exceptID)
+		if err != nil {
+			return err
+		}
+		if taken+participant.GuestCount <= capacity {
+			return nil
+		}
+		if seated {
+			return ErrCapacityExceeded
+		}
+	}
+
+	waitlistedAt := participant.RespondedAt
+	if existing != nil && existing.WaitlistedAt != nil {
+		waitlistedAt = *existing.WaitlistedAt
+	}
+	participant.Status = models.RSVPWaitlisted
+	participant.WaitlistedAt = &waitlistedAt
+	return nil
+}
+
+// promoteWaitlisted, bekleme listesindekileri listeye giriş sırasıyla katılımcı yapar ve yanıt geçmişine yazar.
+// Sıradaki davetlinin kişi sayısı boş yere sığmıyorsa durulur; sonrakiler onun önüne geçemez. Kontenjan yoksa herkes alınır.
+func promoteWaitlisted(tx *gorm.DB, invitationID uint, capacity int, at time.Time) error {
+	var waitlisted []models.InvitationParticipant
+	err := tx.Where("invitation_id = ? AND status = ?", invitationID, models.RSVPWaitlisted).
+		Order("waitlisted_at ASC, id ASC").
+		Find(&waitlisted).Error
+	if err != nil || len(waitlisted) == 0 {
+		return err
+	}
+
+	free := 0
+	if capacity > 0 {
+		taken, err := attendingHeadcount(tx, invitationID, 0)
+		if err != nil {
+			return err
+		}
+		free = capacity - taken
+	}
+	for _, p := range waitlisted {
+		if capacity > 0 {
+			if p.GuestCount > free {
+				break
+			}
+			free -= p.GuestCount
+		}
+		err := tx.Model(&p).Updates(map[string]interface{}{
+			"status":        models.RSVPAttending,
+			"waitlisted_at": nil,
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err := recordResponse(tx, invitationID, p.ID, models.RSVPAttending, p.GuestCount, at); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveAnswers, katılımcının önceki soru yanıtlarını silip yenilerini ekler.
+func saveAnswers(tx *gorm.DB, participant *models.InvitationParticipant) error {
+	if err := tx.Where("participant_id = ?", participant.ID).Delete(&models.RSVPAnswer{}).Error; err != nil {
+		return err
+	}
+	if len(participant.Answers) == 0 {
+		return nil
+	}
+	for i := range participant.Answers {
+		participant.Answers[i].ID = 0
+		participant.Answers[i].ParticipantID = participant.ID
+	}
+	return tx.Create(&participant.Answers).Error
+}
+
+// saveCompanions, katılımcının önceki refakatçilerini silip yenilerini verildikleri sırayla ekler.
+// Adı değişmeyen refakatçinin oturma planındaki masası korunur.
+func saveCompanions(tx *gorm.DB, participant *models.InvitationParticipant) error {
+	var previous []models.ParticipantCompanion
+	if err := tx.Where("participant_id = ? AND table_id IS NOT NULL", participant.ID).Find(&previous).Error; err != nil {
+		return err
+	}
+	tables := make(map[string]*uint, len(previous))
+	for _, companion := range previous {
+		tables[strings.ToLower(companion.Name)] = companion.TableID
+	}
+	if err := tx.Where("participant_id = ?", participant.ID).Delete(&models.ParticipantCompanion{}).Error; err != nil {
+		return err
+	}
+	if len(participant.Companions) == 0 {
+		return nil
+	}
+	for i := range participant.Companions {
+		participant.Companions[i].ID = 0
+		participant.Companions[i].ParticipantID = participant.ID
+		participant.Companions[i].SortOrder = i + 1
+		participant.Companions[i].TableID = tables[strings.ToLower(participant.Companions[i].Name)]
+	}
+	return tx.Create(&participant.Companions).Error
+}
+
+// orderCompanions, refakatçileri katılımcının girdiği sırayla yükler.
+func orderCompanions(db *gorm.DB) *gorm.DB {
+	return db.Order("sort_order ASC, id ASC")
+}
+
+func (r *InvitationRepository) GetParticipantResponses(invitationID uint) ([]models.ParticipantResponse, error) {
+	var responses []models.ParticipantResponse
+	err := r.db.Where("invitation_id = ?", invitationID).
+		Order("created_at DESC, id DESC").
+		Find(&responses).Error
+	return responses, err
+}
@@ -0,0 +1,329 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"davet.link/configs/databaseconfig"
+	"davet.link/models"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Kontenjan ve bekleme listesi satır kilitlerine dayandığı için testler gerçek bir Postgres ister.
+// TEST_DATABASE_DSN verilmezse atlanır; her çalıştırma kendi şemasında yapılır ve sonunda silinir.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN tanımlı değil; Postgres testleri atlanıyor")
+	}
+	config := &gorm.Config{
+		Logger:                                   logger.Default.LogMode(logger.Silent),
+		DisableForeignKeyConstraintWhenMigrating: true,
+	}
+	admin, err := gorm.Open(postgres.Open(dsn), config)
+	if err != nil {
+		t.Fatalf("veritabanına bağlanılamadı: %v", err)
+	}
+	schema := fmt.Sprintf("repositories_test_%d", time.Now().UnixNano())
+	if err := admin.Exec("CREATE SCHEMA " + schema).Error; err != nil {
+		t.Fatalf("şema oluşturulamadı: %v", err)
+	}
+	db, err := gorm.Open(postgres.Open(dsn+" search_path="+schema), config)
+	if err != nil {
+		t.Fatalf("veritabanına bağlanılamadı: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+		_ = admin.Exec("DROP SCHEMA " + schema + " CASCADE").Error
+		if sqlDB, err := admin.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	err = db.AutoMigrate(
+		&models.Invitation{},
+		&models.InvitationParticipant{},
+		&models.ParticipantResponse{},
+		&models.RSVPAnswer{},
+		&models.ParticipantCompanion{},
+	)
+	if err != nil {
+		t.Fatalf("tablolar oluşturulamadı: %v", err)
+	}
+	previous := databaseconfig.DB
+	databaseconfig.DB = db
+	t.Cleanup(func() { databaseconfig.DB = previous })
+	return db
+}
+
+func createTestInvitation(t *testing.T, db *gorm.DB, capacity int) *models.Invitation {
+	t.Helper()
+	invitation := &models.Invitation{
+		InvitationKey: fmt.Sprintf("test-%d", time.Now().UnixNano()),
+		UserID:        1,
+		CategoryID:    1,
+		Template:      "wedding",
+		MaxHeadcount:  capacity,
+		IsParticipant: true,
+	}
+	if err := db.Create(invitation).Error; err != nil {
+		t.Fatalf("davetiye oluşturulamadı: %v", err)
+	}
+	return invitation
+}
+
+var testRespondedAt = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// submit, verilen telefonla katılacağını bildirir; her bildirim bir öncekinden bir dakika sonra yapılmış sayılır.
+func submit(t *testing.T, repo IInvitationRepository, invitationID uint, phone string, guestCount int) (*models.InvitationParticipant, error) {
+	t.Helper()
+	testRespondedAt = testRespondedAt.Add(time.Minute)
+	participant := &models.InvitationParticipant{
+		InvitationID: invitationID,
+		Title:        "Davetli " + phone,
+		PhoneNumber:  phone,
+		GuestCount:   guestCount,
+		Status:       models.RSVPAttending,
+		RespondedAt:  testRespondedAt,
+	}
+	_, err := repo.SaveParticipantByPhone(participant)
+	return participant, err
+}
+
+func statusOf(t *testing.T, db *gorm.DB, id uint) models.RSVPStatus {
+	t.Helper()
+	var participant models.InvitationParticipant
+	if err := db.First(&participant, id).Error; err != nil {
+		t.Fatalf("katılımcı okunamadı: %v", err)
+	}
+	return participant.Status
+}
+
+func TestSaveParticipantByPhoneWaitlistsOverCapacity(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewInvitationRepository()
+	invitation := createTestInvitation(t, db, 3)
+
+	first, err := submit(t, repo, invitation.ID, "+905550000001", 2)
+	if err != nil || first.Status != models.RSVPAttending {
+		t.Fatalf("ilk bildirim: status=%s err=%v, attending bekleniyordu", first.Status, err)
+	}
+	second, err := submit(t, repo, invitation.ID, "+905550000002", 2)
+	if err != nil {
+		t.Fatalf("ikinci bildirim: %v", err)
+	}
+	if second.Status != models.RSVPWaitlisted || second.WaitlistedAt == nil {
+		t.Fatalf("ikinci bildirim: status=%s waitlisted_at=%v, bekleme listesi bekleniyordu", second.Status, second.WaitlistedAt)
+	}
+	if got := statusOf(t, db, second.ID); got != models.RSVPWaitlisted {
+		t.Fatalf("kaydedilen durum %s, waitlisted bekleniyordu", got)
+	}
+
+	headcount, err := repo.GetAttendingHeadcount(invitation.ID)
+	if err != nil || headcount != 2 {
+		t.Fatalf("katılan kişi sayısı %d (err=%v), 2 bekleniyordu", headcount, err)
+	}
+
+	// Yeri ayrılmış katılımcı kişi sayısını kontenjanın üzerine çıkaramaz
+	if _, err := submit(t, repo, invitation.ID, "+905550000001", 4); !errors.Is(err, ErrCapacityExceeded) {
+		t.Fatalf("kontenjanı aşan güncelleme: err=%v, ErrCapacityExceeded bekleniyordu", err)
+	}
+	if got := statusOf(t, db, first.ID); got != models.RSVPAttending {
+		t.Fatalf("reddedilen güncellemeden sonra durum %s, attending bekleniyordu", got)
+	}
+}
+
+func TestSaveParticipantByPhoneKeepsWaitlistOrder(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewInvitationRepository()
+	invitation := createTestInvitation(t, db, 5)
+
+	if _, err := submit(t, repo, invitation.ID, "+905550000001", 2); err != nil {
+		t.Fatal(err)
+	}
+	head, err := submit(t, repo, invitation.ID, "+905550000002", 5)
+	if err != nil || head.Status != models.RSVPWaitlisted {
+		t.Fatalf("beş kişilik grup: status=%s err=%v, waitlisted bekleniyordu", head.Status, err)
+	}
+
+	// Üç kişilik boş yer yeni gelen iki kişiye yeter, ama bekleme listesindekinin önüne geçemez
+	newcomer, err := submit(t, repo, invitation.ID, "+905550000003", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newcomer.Status != models.RSVPWaitlisted || newcomer.WaitlistedAt == nil {
+		t.Fatalf("yeni gelen: status=%s waitlisted_at=%v, bekleme listesi bekleniyordu", newcomer.Status, newcomer.WaitlistedAt)
+	}
+
+	// Sıranın başındaki grup kişi sayısını boş yere indirince alınır; arkasındaki sırasını korur
+	resubmitted, err := submit(t, repo, invitation.ID, "+905550000002", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := statusOf(t, db, resubmitted.ID); got != models.RSVPAttending {
+		t.Fatalf("sıranın başındaki grup %s, attending bekleniyordu", got)
+	}
+	if got := statusOf(t, db, newcomer.ID); got != models.RSVPWaitlisted {
+		t.Fatalf("yeni gelen %s, waitlisted bekleniyordu", got)
+	}
+	headcount, err := repo.GetAttendingHeadcount(invitation.ID)
+	if err != nil || headcount != 5 {
+		t.Fatalf("katılan kişi sayısı %d (err=%v), 5 bekleniyordu", headcount, err)
+	}
+}
+
+func TestUpdateAndDeletePromoteWaitlistInOrder(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewInvitationRepository()
+	invitation := createTestInvitation(t, db, 4)
+
+	host, err := submit(t, repo, invitation.ID, "+905550000001", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	large, err := submit(t, repo, invitation.ID, "+905550000002", 3)
+	if err != nil || large.Status != models.RSVPWaitlisted {
+		t.Fatalf("büyük grup: status=%s err=%v", large.Status, err)
+	}
+	small, err := submit(t, repo, invitation.ID, "+905550000003", 1)
+	if err != nil || small.Status != models.RSVPWaitlisted {
+		t.Fatalf("küçük grup: status=%s err=%v", small.Status, err)
+	}
+
+	// Açılan iki kişilik yer sıradaki üç kişilik gruba yetmez; arkasındaki küçük grup öne geçemez
+	if err := repo.UpdateParticipant(host.ID, &models.InvitationParticipant{GuestCount: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if got := statusOf(t, db, large.ID); got != models.RSVPWaitlisted {
+		t.Fatalf("büyük grup %s, waitlisted bekleniyordu", got)
+	}
+	if got := statusOf(t, db, small.ID); got != models.RSVPWaitlisted {
+		t.Fatalf("küçük grup sırayı atladı: %s", got)
+	}
+
+	// Silinen katılımcının yeri iki gruba da yeter; ikisi de giriş sırasıyla katılımcı olur
+	if err := repo.DeleteParticipant(host.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := statusOf(t, db, large.ID); got != models.RSVPAttending {
+		t.Fatalf("büyük grup %s, attending bekleniyordu", got)
+	}
+	if got := statusOf(t, db, small.ID); got != models.RSVPAttending {
+		t.Fatalf("küçük grup %s, attending bekleniyordu", got)
+	}
+
+	var promoted []models.ParticipantResponse
+	err = db.Where("invitation_id = ? AND status = ?", invitation.ID, models.RSVPAttending).
+		Where("participant_id IN ?", []uint{large.ID, small.ID}).
+		Order("id ASC").
+		Find(&promoted).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(promoted) != 2 || promoted[0].ParticipantID != large.ID || promoted[1].ParticipantID != small.ID {
+		t.Fatalf("yanıt geçmişinde giriş sırasıyla iki terfi kaydı bekleniyordu: %+v", promoted)
+	}
+}
+
+func TestUpdateAndDeleteParticipantRecordHistory(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewInvitationRepository()
+	invitation := createTestInvitation(t, db, 0)
+
+	participant, err := submit(t, repo, invitation.ID, "+905550000001", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Yalnızca adın değişmesi geçmişe yazılmaz
+	if err := repo.UpdateParticipant(participant.ID, &models.InvitationParticipant{Title: "Yeni Ad"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.UpdateParticipant(participant.ID, &models.InvitationParticipant{GuestCount: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.DeleteParticipant(participant.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	var history []models.ParticipantResponse
+	if err := db.Where("participant_id = ?", participant.ID).Order("id ASC").Find(&history).Error; err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		status     models.RSVPStatus
+		guestCount int
+	}{
+		{models.RSVPAttending, 2},
+		{models.RSVPAttending, 3},
+		{models.RSVPRemoved, 0},
+	}
+	if len(history) != len(want) {
+		t.Fatalf("yanıt geçmişinde %d kayıt var, %d bekleniyordu: %+v", len(history), len(want), history)
+	}
+	for i, response := range history {
+		if response.Status != want[i].status || response.GuestCount != want[i].guestCount || response.InvitationID != invitation.ID {
+			t.Errorf("%d. kayıt %s/%d, %s/%d bekleniyordu", i, response.Status, response.GuestCount, want[i].status, want[i].guestCount)
+		}
+	}
+}
+
+func TestSaveParticipantByPhoneConcurrentLastSeat(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewInvitationRepository()
+	invitation := createTestInvitation(t, db, 3)
+	if _, err := submit(t, repo, invitation.ID, "+905550000001", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// Kalan iki kişilik yere aynı anda iki grup başvurur; yalnızca biri yer alabilir
+	const submitters = 2
+	participants := make([]*models.InvitationParticipant, submitters)
+	errs := make([]error, submitters)
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < submitters; i++ {
+		participants[i] = &models.InvitationParticipant{
+			InvitationID: invitation.ID,
+			Title:        fmt.Sprintf("Eşzamanlı %d", i),
+			PhoneNumber:  fmt.Sprintf("+90555000010%d", i),
+			GuestCount:   2,
+			Status:       models.RSVPAttending,
+			RespondedAt:  testRespondedAt.Add(time.Hour),
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = repo.SaveParticipantByPhone(participants[i])
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	attending := 0
+	for i := range participants {
+		if errs[i] != nil {
+			t.Fatalf("eşzamanlı bildirim %d: %v", i, errs[i])
+		}
+		if statusOf(t, db, participants[i].ID) == models.RSVPAttending {
+			attending++
+		}
+	}
+	if attending != 1 {
+		t.Fatalf("son yere %d grup yerleşti, 1 bekleniyordu", attending)
+	}
+	headcount, err := repo.GetAttendingHeadcount(invitation.ID)
+	if err != nil || headcount != 3 {
+		t.Fatalf("katılan kişi sayısı %d (err=%v), 3 bekleniyordu", headcount, err)
+	}
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"davet.link/configs/databaseconfig"
+	"davet.link/models"
+	"davet.link/pkg/queryparams"
+
+	"gorm.io/gorm"
+)
+
+type IPageRepository interface {
+	GetAllPages(params queryparams.ListParams) ([]models.Page, int64, error)
+	GetPageByID(id uint) (*models.Page, error)
+	PageSlugExists(slug string, exceptID uint) (bool, error)
+	GetPublishedPageBySlug(slug string) (*models.Page, error)
+	GetPublishedPages() ([]models.Page, error)
+	CreatePage(ctx context.Context, page *models.Page) error
+	UpdatePage(ctx context.Context, id uint, data map[string]interface{}, updatedBy uint) error
+	DeletePage(ctx context.Context, id uint) error
+	GetPageCount() (int64, error)
+}
+
+type PageRepository struct {
+	base IBaseRepository[models.Page]
+	db   *gorm.DB
+}
+
+func NewPageRepository() IPageRepository {
+	base := NewBaseRepository[models.Page](databaseconfig.GetDB())
+	base.SetAllowedSortColumns([]string{"id", "slug", "title", "is_published", "created_at", "updated_at"})
+	return &PageRepository{base: base, db: databaseconfig.GetDB()}
+}
+
+func (r *PageRepository) GetAllPages(params queryparams.ListParams) ([]models.Page, int64, error) {
+	return r.base.GetAll(params)
+}
+
+func (r *PageRepository) GetPageByID(id uint) (*models.Page, error) {
+	return r.base.GetByID(id)
+}
+
+func (r *PageRepository) PageSlugExists(slug string, exceptID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Page{}).
+		Where("LOWER(slug) = LOWER(?) AND id <> ?", slug, exceptID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *PageRepository) GetPublishedPageBySlug(slug string) (*models.Page, error) {
+	var page models.Page
+	err := r.db.Where("slug = ? AND is_published = ?", slug, true).First(&page).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// Site haritası için yalnızca slug ve güncellenme zamanı okunur
+func (r *PageRepository) GetPublishedPages() ([]models.Page, error) {
+	var pages []models.Page
+	err := r.db.
+		Select("id", "slug", "updated_at").
+		Where("is_published = ?", true).
+		Order("id ASC").
+		Find(&pages).Error
+	return pages, err
+}
+
+func (r *PageRepository) CreatePage(ctx context.Context, page *models.Page) error {
+	return r.base.Create(ctx, page)
+}
+
+func (r *PageRepository) UpdatePage(ctx context.Context, id uint, data map[string]interface{}, updatedBy uint) error {
+	return r.base.Update(ctx, id, data, updatedBy)
+}
+
+// Slug benzersiz indeksini serbest bırakmak için sayfa kalıcı olarak silinir
+func (r *PageRepository) DeletePage(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Unscoped().Delete(&models.Page{}, id).Error
+}
+
+func (r *PageRepository) GetPageCount() (int64, error) {
+	return r.base.GetCount()
+}
+
+var _ IPageRepository = (*PageRepository)(nil)
+var _ IBaseRepository[models.Page] = (*BaseRepository[models.Page])(nil)
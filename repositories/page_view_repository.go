@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"time"
+
+	"davet.link/configs/databaseconfig"
+	"davet.link/models"
+
+	"gorm.io/gorm"
+)
+
+const pageViewInsertBatchSize = 500
+
+type PageViewDailyCount struct {
+	Day      time.Time
+	Views    int64
+	Visitors int64
+}
+
+type PageViewGroupCount struct {
+	Label string
+	Count int64
+}
+
+type IPageViewRepository interface {
+	CreatePageViews(views []models.PageView) error
+	GetPageViewTotals(target models.PageViewTarget, targetID uint) (int64, int64, error)
+	GetDailyPageViews(target models.PageViewTarget, targetID uint, since time.Time) ([]PageViewDailyCount, error)
+	GetPageViewReferrers(target models.PageViewTarget, targetID uint, since time.Time, limit int) ([]PageViewGroupCount, error)
+	GetPageViewDevices(target models.PageViewTarget, targetID uint, since time.Time) ([]PageViewGroupCount, error)
+}
+
+type PageViewRepository struct {
+	db *gorm.DB
+}
+
+func NewPageViewRepository() IPageViewRepository {
+	return &PageViewRepository{db: databaseconfig.GetDB()}
+}
+
+func (r *PageViewRepository) CreatePageViews(views []models.PageView) error {
+	if len(views) == 0 {
+		return nil
+	}
+	return r.db.CreateInBatches(views, pageViewInsertBatchSize).Error
+}
+
+// Ziyaretçi özeti günlük tuzla üretildiğinden farklı özet sayısı, günlük tekil ziyaretçilerin toplamıdır
+func (r *PageViewRepository) GetPageViewTotals(target models.PageViewTarget, targetID uint) (int64, int64, error) {
+	var totals struct {
+		Views    int64
+		Visitors int64
+	}
+	err := r.targetQuery(target, targetID).
+		Select("COUNT(*) AS views, COUNT(DISTINCT visitor_hash) AS visitors").
+		Scan(&totals).Error
+	return totals.Views, totals.Visitors, err
+}
+
+func (r *PageViewRepository) GetDailyPageViews(target models.PageViewTarget, targetID uint, since time.Time) ([]PageViewDailyCount, error) {
+	var counts []PageViewDailyCount
+	err := r.targetQuery(target, targetID).
+		Select("day, COUNT(*) AS views, COUNT(DISTINCT visitor_hash) AS visitors").
+		Where("day >= ?", since).
+		Group("day").
+		Order("day ASC").
+		Scan(&counts).Error
+	return counts, err
+}
+
+func (r *PageViewRepository) GetPageViewReferrers(target models.PageViewTarget, targetID uint, since time.Time, limit int) ([]PageViewGroupCount, error) {
+	var counts []PageViewGroupCount
+	err := r.targetQuery(target, targetID).
+		Select("referrer_host AS label, COUNT(*) AS count").
+		Where("day >= ?", since).
+		Group("referrer_host").
+		Order("count DESC").
+		Limit(limit).
+		Scan(&counts).Error
+	return counts, err
+}
+
+func (r *PageViewRepository) GetPageViewDevices(target models.PageViewTarget, targetID uint, since time.Time) ([]PageViewGroupCount, error) {
+	var counts []PageViewGroupCount
+	err := r.targetQuery(target, targetID).
+		Select("device_class AS label, COUNT(*) AS count").
+		Where("day >= ?", since).
+		Group("device_class").
+		Order("count DESC").
+		Scan(&counts).Error
+	return counts, err
+}
+
+func (r *PageViewRepository) targetQuery(target models.PageViewTarget, targetID uint) *gorm.DB {
+	return r.db.Model(&models.PageView{}).
+		Where("target_type = ? AND target_id = ?", target, targetID)
+}
+
+var _ IPageViewRepository = (*PageViewRepository)(nil)
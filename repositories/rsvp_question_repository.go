@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"davet.link/configs/databaseconfig"
+	"davet.link/models"
+
+	"gorm.io/gorm"
+)
+
+type IRSVPQuestionRepository interface {
+	CreateQuestion(ctx context.Context, question *models.RSVPQuestion) error
+	GetQuestion(invitationID, id uint) (*models.RSVPQuestion, error)
+	// GetQuestionsByInvitationID, soruları formdaki sırasıyla döndürür.
+	GetQuestionsByInvitationID(invitationID uint) ([]models.RSVPQuestion, error)
+	CountQuestions(invitationID uint) (int64, error)
+	NextSortOrder(invitationID uint) (int, error)
+	UpdateQuestion(ctx context.Context, id uint, data map[string]interface{}) error
+	SwapSortOrder(ctx context.Context, first, second *models.RSVPQuestion) error
+	// DeleteQuestion, soruyu ve ona verilmiş yanıtları siler.
+	DeleteQuestion(ctx context.Context, id uint) error
+}
+
+type RSVPQuestionRepository struct {
+	db *gorm.DB
+}
+
+func NewRSVPQuestionRepository() IRSVPQuestionRepository {
+	return &RSVPQuestionRepository{db: databaseconfig.GetDB()}
+}
+
+func (r *RSVPQuestionRepository) CreateQuestion(ctx context.Context, question *models.RSVPQuestion) error {
+	return r.db.WithContext(ctx).Create(question).Error
+}
+
+func (r *RSVPQuestionRepository) GetQuestion(invitationID, id uint) (*models.RSVPQuestion, error) {
+	var question models.RSVPQuestion
+	err := r.db.Where("id = ? AND invitation_id = ?", id, invitationID).First(&question).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &question, nil
+}
+
+func (r *RSVPQuestionRepository) GetQuestionsByInvitationID(invitationID uint) ([]models.RSVPQuestion, error) {
+	var questions []models.RSVPQuestion
+	err := r.db.Where("invitation_id = ?", invitationID).Order("sort_order ASC, id ASC").Find(&questions).Error
+	return questions, err
+}
+
+func (r *RSVPQuestionRepository) CountQuestions(invitationID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.RSVPQuestion{}).Where("invitation_id = ?", invitationID).Count(&count).Error
+	return count, err
+}
+
+func (r *RSVPQuestionRepository) NextSortOrder(invitationID uint) (int, error) {
+	var maxOrder *int
+	err := r.db.Model(&models.RSVPQuestion{}).
+		Select("MAX(sort_order)").
+		Where("invitation_id = ?", invitationID).
+		Scan(&maxOrder).Error
+	if err != nil || maxOrder == nil {
+		return 0, err
+	}
+	return *maxOrder + 1, nil
+}
+
+func (r *RSVPQuestionRepository) UpdateQuestion(ctx context.Context, id uint, data map[string]interface{}) error {
+	result := r.db.WithContext(ctx).Model(&models.RSVPQuestion{}).Where("id = ?", id).Updates(data)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *RSVPQuestionRepository) SwapSortOrder(ctx context.Context, first, second *models.RSVPQuestion) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.RSVPQuestion{}).Where("id = ?", first.ID).Update("sort_order", second.SortOrder).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.RSVPQuestion{}).Where("id = ?", second.ID).Update("sort_order", first.SortOrder).Error
+	})
+}
+
+func (r *RSVPQuestionRepository) DeleteQuestion(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.RSVPQuestion{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		return tx.Where("question_id = ?", id).Delete(&models.RSVPAnswer{}).Error
+	})
+}
+
+var _ IRSVPQuestionRepository = (*RSVPQuestionRepository)(nil)
@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"davet.link/configs/databaseconfig"
+	"davet.link/models"
+
+	"gorm.io/gorm"
+)
+
+type ISeatingRepository interface {
+	CreateTable(ctx context.Context, table *models.SeatingTable) error
+	GetTable(invitationID, id uint) (*models.SeatingTable, error)
+	// GetTablesByInvitationID, masaları oturma planındaki sırasıyla döndürür.
+	GetTablesByInvitationID(invitationID uint) ([]models.SeatingTable, error)
+	CountTables(invitationID uint) (int64, error)
+	NextSortOrder(invitationID uint) (int, error)
+	UpdateTable(ctx context.Context, id uint, data map[string]interface{}) error
+	// DeleteTable, masayı siler; masaya atanmış katılımcılar ve refakatçiler masasız kalır.
+	DeleteTable(ctx context.Context, id uint) error
+	// AssignSeats, katılımcının masasını ve refakatçilerinin ayrı masalarını tek işlemde kaydeder.
+	// companionTables'ta bulunmayan refakatçiler katılımcının masasına döner.
+	AssignSeats(ctx context.Context, participantID uint, tableID *uint, companionTables map[uint]uint) error
+}
+
+type SeatingRepository struct {
+	db *gorm.DB
+}
+
+func NewSeatingRepository() ISeatingRepository {
+	return &SeatingRepository{db: databaseconfig.GetDB()}
+}
+
+func (r *SeatingRepository) CreateTable(ctx context.Context, table *models.SeatingTable) error {
+	return r.db.WithContext(ctx).Create(table).Error
+}
+
+func (r *SeatingRepository) GetTable(invitationID, id uint) (*models.SeatingTable, error) {
+	var table models.SeatingTable
+	err := r.db.Where("id = ? AND invitation_id = ?", id, invitationID).First(&table).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &table, nil
+}
+
+func (r *SeatingRepository) GetTablesByInvitationID(invitationID uint) ([]models.SeatingTable, error) {
+	var tables []models.SeatingTable
+	err := r.db.Where("invitation_id = ?", invitationID).Order("sort_order ASC, id ASC").Find(&tables).Error
+	return tables, err
+}
+
+func (r *SeatingRepository) CountTables(invitationID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.SeatingTable{}).Where("invitation_id = ?", invitationID).Count(&count).Error
+	return count, err
+}
+
+func (r *SeatingRepository) NextSortOrder(invitationID uint) (int, error) {
+	var maxOrder *int
+	err := r.db.Model(&models.SeatingTable{}).
+		Select("MAX(sort_order)").
+		Where("invitation_id = ?", invitationID).
+		Scan(&maxOrder).Error
+	if err != nil || maxOrder == nil {
+		return 0, err
+	}
+	return *maxOrder + 1, nil
+}
+
+func (r *SeatingRepository) UpdateTable(ctx context.Context, id uint, data map[string]interface{}) error {
+	result := r.db.WithContext(ctx).Model(&models.SeatingTable{}).Where("id = ?", id).Updates(data)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *SeatingRepository) DeleteTable(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.SeatingTable{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		err := tx.Model(&models.InvitationParticipant{}).Where("table_id = ?", id).Update("table_id", nil).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&models.ParticipantCompanion{}).Where("table_id = ?", id).Update("table_id", nil).Error
+	})
+}
+
+func (r *SeatingRepository) AssignSeats(ctx context.Context, participantID uint, tableID *uint, companionTables map[uint]uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.InvitationParticipant{}).Where("id = ?", participantID).Update("table_id", tableID).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&models.ParticipantCompanion{}).Where("participant_id = ?", participantID).Update("table_id", nil).Error
+		if err != nil {
+			return err
+		}
+		for companionID, companionTableID := range companionTables {
+			err := tx.Model(&models.ParticipantCompanion{}).
+				Where("id = ? AND participant_id = ?", companionID, participantID).
+				Update("table_id", companionTableID).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+var _ ISeatingRepository = (*SeatingRepository)(nil)
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"errors"
 
 	"davet.link/configs/databaseconfig"
 	"davet.link/models"
@@ -13,6 +14,7 @@ import (
 type IUserRepository interface {
 	GetAllUsers(params queryparams.ListParams) ([]models.User, int64, error)
 	GetUserByID(id uint) (*models.User, error)
+	GetUserByCalendarToken(token string) (*models.User, error)
 	CreateUser(ctx context.Context, user *models.User) error
 	BulkCreateUsers(ctx context.Context, users []models.User) error
 	UpdateUser(ctx context.Context, id uint, data map[string]interface{}, updatedBy uint) error
@@ -42,6 +44,21 @@ func (r *UserRepository) GetUserByID(id uint) (*models.User, error) {
 	return r.base.GetByID(id)
 }
 
+func (r *UserRepository) GetUserByCalendarToken(token string) (*models.User, error) {
+	if token == "" {
+		return nil, ErrNotFound
+	}
+	var user models.User
+	err := r.db.Where("calendar_token = ? AND status = ?", token, true).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
 func (r *UserRepository) CreateUser(ctx context.Context, user *models.User) error {
 	return r.base.Create(ctx, user)
 }
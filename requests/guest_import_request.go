@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// Davet listesi içe aktarmada önizlemeden sonra gönderilen sütun eşlemesi.
+// Data, önizlemede okunan satırları CSV olarak taşır; -1 sütunun kullanılmadığını belirtir.
+type GuestImportRequest struct {
+	Data          string `form:"data" validate:"required"`
+	NameColumn    int    `form:"name_column" validate:"min=0"`
+	SurnameColumn int    `form:"surname_column" validate:"min=-1"`
+	PhoneColumn   int    `form:"phone_column" validate:"min=-1"`
+	HasHeader     bool   `form:"has_header"`
+}
+
+func ValidateGuestImportRequest(c *fiber.Ctx) error {
+	var req GuestImportRequest
+	errorMessages := map[string]string{
+		"Data_required":     "İçe aktarılacak satır bulunamadı, lütfen dosyayı yeniden yükleyin",
+		"NameColumn_min":    "Ad Soyad sütunu seçilmelidir",
+		"SurnameColumn_min": "Geçersiz sütun seçimi",
+		"PhoneColumn_min":   "Geçersiz sütun seçimi",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/panel/invitations/guests/"+c.Params("id")+"/import"); err != nil {
+		return err
+	}
+	c.Locals("guestImportRequest", req)
+	return c.Next()
+}
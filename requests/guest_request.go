@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// Davet listesine eklenen, kişiye özel bağlantı gönderilecek davetli
+type GuestRequest struct {
+	Name        string `form:"name" validate:"required,min=2,max=255"`
+	PhoneNumber string `form:"phone_number" validate:"omitempty,min=10,max=20"`
+}
+
+func ValidateGuestRequest(c *fiber.Ctx) error {
+	var req GuestRequest
+	errorMessages := map[string]string{
+		"Name_required":   "Ad Soyad zorunludur",
+		"Name_min":        "Ad Soyad en az 2 karakter olmalıdır",
+		"Name_max":        "Ad Soyad en fazla 255 karakter olabilir",
+		"PhoneNumber_min": "Telefon numarası en az 10 karakter olmalıdır",
+		"PhoneNumber_max": "Telefon numarası en fazla 20 karakter olabilir",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/panel/invitations/guests/"+c.Params("id")); err != nil {
+		return err
+	}
+	c.Locals("guestRequest", req)
+	return c.Next()
+}
@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// Davetiye sayfasından bırakılan tebrik mesajı
+type GuestbookRequest struct {
+	Name    string `form:"name" validate:"required,min=2,max=100"`
+	Message string `form:"message" validate:"required,min=2,max=1000"`
+	// Gerçek ziyaretçilere gösterilmeyen tuzak alan; doluysa istek bir bottan gelmiştir
+	Website string `form:"website"`
+}
+
+type GuestbookStatusRequest struct {
+	Status string `form:"status" validate:"required,oneof=pending approved hidden"`
+}
+
+func ValidateGuestbookRequest(c *fiber.Ctx) error {
+	var req GuestbookRequest
+	errorMessages := map[string]string{
+		"Name_required":    "Ad Soyad zorunludur",
+		"Name_min":         "Ad Soyad en az 2 karakter olmalıdır",
+		"Name_max":         "Ad Soyad en fazla 100 karakter olabilir",
+		"Message_required": "Mesaj zorunludur",
+		"Message_min":      "Mesaj en az 2 karakter olmalıdır",
+		"Message_max":      "Mesaj en fazla 1000 karakter olabilir",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/"+c.Params("invitationKey")); err != nil {
+		return err
+	}
+	c.Locals("guestbookRequest", req)
+	return c.Next()
+}
+
+func ValidateGuestbookStatusRequest(c *fiber.Ctx) error {
+	var req GuestbookStatusRequest
+	errorMessages := map[string]string{
+		"Status_required": "Geçersiz mesaj durumu",
+		"Status_oneof":    "Geçersiz mesaj durumu",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/panel/invitations/guestbook/"+c.Params("id")); err != nil {
+		return err
+	}
+	c.Locals("guestbookStatusRequest", req)
+	return c.Next()
+}
@@ -0,0 +1,68 @@
+package requests
+
+import (
+	"strings"
+	"time"
+
+	"davet.link/pkg/eventtime"
+	"davet.link/pkg/flashmessages"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Davetiye programındaki bir bölüm (ör: kına gecesi, nikah, düğün)
+type InvitationEventRequest struct {
+	Name      string `form:"name" validate:"required,min=2,max=100"`
+	Date      string `form:"date" validate:"required"`
+	StartTime string `form:"start_time" validate:"required"`
+	EndTime   string `form:"end_time"`
+	Venue     string `form:"venue" validate:"max=255"`
+	Address   string `form:"address" validate:"max=255"`
+	Location  string `form:"location" validate:"max=255"`
+}
+
+func ValidateInvitationEventRequest(c *fiber.Ctx) error {
+	var req InvitationEventRequest
+	errorMessages := map[string]string{
+		"Name_required":      "Bölüm adı zorunludur",
+		"Name_min":           "Bölüm adı en az 2 karakter olmalıdır",
+		"Name_max":           "Bölüm adı en fazla 100 karakter olabilir",
+		"Date_required":      "Tarih zorunludur",
+		"StartTime_required": "Başlangıç saati zorunludur",
+		"Venue_max":          "Mekân en fazla 255 karakter olabilir",
+		"Address_max":        "Adres en fazla 255 karakter olabilir",
+		"Location_max":       "Konum en fazla 255 karakter olabilir",
+	}
+	redirectPath := "/panel/invitations/programme/" + c.Params("id")
+	if err := validateRequest(c, &req, errorMessages, redirectPath); err != nil {
+		return err
+	}
+	// Saat dilimi henüz bilinmediğinden yalnızca yazım denetlenir; zaman handler'da davetiyenin diliminde çözümlenir
+	if _, _, err := req.Schedule(time.UTC); err != nil {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, err.Error())
+		return c.Redirect(redirectPath, fiber.StatusSeeOther)
+	}
+	c.Locals("invitationEventRequest", req)
+	return c.Next()
+}
+
+// Schedule, bölümün başlangıç ve bitiş zamanını davetiyenin saat diliminde çözümler.
+// Tarih "21.06.2025" veya "21 Haziran 2025" gibi Türkçe yazılabilir.
+// Bitiş saati başlangıçtan önceyse bölüm gece yarısını geçiyor kabul edilir; bitiş yoksa nil döner.
+func (r InvitationEventRequest) Schedule(loc *time.Location) (time.Time, *time.Time, error) {
+	start, _, err := eventtime.At(r.Date, r.StartTime, loc)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if strings.TrimSpace(r.EndTime) == "" {
+		return start, nil, nil
+	}
+	end, _, err := eventtime.At(r.Date, r.EndTime, loc)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if !end.After(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, &end, nil
+}
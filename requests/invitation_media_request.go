@@ -0,0 +1,38 @@
+package requests
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type InvitationMediaCaptionRequest struct {
+	Caption string `form:"caption" validate:"max=255"`
+}
+
+type InvitationMediaMoveRequest struct {
+	Direction string `form:"direction" validate:"required,oneof=up down"`
+}
+
+func ValidateInvitationMediaCaptionRequest(c *fiber.Ctx) error {
+	var req InvitationMediaCaptionRequest
+	errorMessages := map[string]string{
+		"Caption_max": "Açıklama en fazla 255 karakter olabilir",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/panel/invitations/gallery/"+c.Params("id")); err != nil {
+		return err
+	}
+	c.Locals("invitationMediaCaptionRequest", req)
+	return c.Next()
+}
+
+func ValidateInvitationMediaMoveRequest(c *fiber.Ctx) error {
+	var req InvitationMediaMoveRequest
+	errorMessages := map[string]string{
+		"Direction_required": "Geçersiz taşıma yönü",
+		"Direction_oneof":    "Geçersiz taşıma yönü",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/panel/invitations/gallery/"+c.Params("id")); err != nil {
+		return err
+	}
+	c.Locals("invitationMediaMoveRequest", req)
+	return c.Next()
+}
@@ -1,6 +1,8 @@
 package requests
 
 import (
+	"davet.link/models"
+
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -10,6 +12,37 @@ type InvitationParticipantRequest struct {
 	GuestCount  int    `form:"guest_count" validate:"required,min=1"`
 }
 
+// Davetiye sayfasından gönderilen katılım bildirimi
+type RSVPRequest struct {
+	Title       string `form:"title" validate:"required,min=2,max=255"`
+	PhoneNumber string `form:"phone_number" validate:"required,min=10,max=20"`
+	// Katılmayanlar için gönderilmeyebilir; katılanlarda en az 1 olması serviste denetlenir
+	GuestCount int    `form:"guest_count" validate:"min=0,max=20"`
+	Status     string `form:"status" validate:"omitempty,oneof=attending declined maybe"`
+	// Kişiye özel bağlantıdan gelindiyse davetlinin anahtarı
+	GuestToken string `form:"guest_token" validate:"omitempty,hexadecimal,len=32"`
+	// Refakatçi satırları; her dizinin aynı sıradaki elemanı aynı kişiye aittir
+	CompanionNames []string `form:"companion_name" validate:"max=19"`
+	CompanionAges  []string `form:"companion_age" validate:"max=19"`
+	CompanionDiets []string `form:"companion_diet" validate:"max=19"`
+}
+
+// Companions, refakatçi satırlarını birleştirir; eksik alanlar boş kabul edilir.
+func (r RSVPRequest) Companions() []models.ParticipantCompanion {
+	companions := make([]models.ParticipantCompanion, 0, len(r.CompanionNames))
+	for i, name := range r.CompanionNames {
+		companion := models.ParticipantCompanion{Name: name}
+		if i < len(r.CompanionAges) {
+			companion.AgeGroup = models.CompanionAgeGroup(r.CompanionAges[i])
+		}
+		if i < len(r.CompanionDiets) {
+			companion.DietaryNote = r.CompanionDiets[i]
+		}
+		companions = append(companions, companion)
+	}
+	return companions
+}
+
 func ValidateInvitationParticipantRequest(c *fiber.Ctx) error {
 	var req InvitationParticipantRequest
 	errorMessages := map[string]string{
@@ -26,3 +59,28 @@ func ValidateInvitationParticipantRequest(c *fiber.Ctx) error {
 	c.Locals("invitationParticipantRequest", req)
 	return nil
 }
+
+func ValidateRSVPRequest(c *fiber.Ctx) error {
+	var req RSVPRequest
+	errorMessages := map[string]string{
+		"Title_required":         "Ad Soyad zorunludur",
+		"Title_min":              "Ad Soyad en az 2 karakter olmalıdır",
+		"Title_max":              "Ad Soyad en fazla 255 karakter olabilir",
+		"PhoneNumber_required":   "Telefon numarası zorunludur",
+		"PhoneNumber_min":        "Telefon numarası en az 10 karakter olmalıdır",
+		"PhoneNumber_max":        "Telefon numarası en fazla 20 karakter olabilir",
+		"GuestCount_min":         "Kişi sayısı en az 1 olmalıdır",
+		"GuestCount_max":         "Kişi sayısı en fazla 20 olabilir",
+		"Status_oneof":           "Lütfen katılım durumunuzu seçin",
+		"GuestToken_hexadecimal": "Geçersiz davetli bağlantısı",
+		"GuestToken_len":         "Geçersiz davetli bağlantısı",
+		"CompanionNames_max":     "En fazla 19 refakatçi bildirilebilir",
+		"CompanionAges_max":      "En fazla 19 refakatçi bildirilebilir",
+		"CompanionDiets_max":     "En fazla 19 refakatçi bildirilebilir",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/"+c.Params("invitationKey")); err != nil {
+		return err
+	}
+	c.Locals("rsvpRequest", req)
+	return c.Next()
+}
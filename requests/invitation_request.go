@@ -1,6 +1,15 @@
 package requests
 
 import (
+	"errors"
+	"strings"
+	"time"
+
+	"davet.link/configs/envconfig"
+	"davet.link/models"
+	"davet.link/pkg/eventtime"
+	"davet.link/pkg/flashmessages"
+
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -21,13 +30,26 @@ type InvitationRequest struct {
 	Note              string   `form:"note"`
 	Date              string   `form:"date"`
 	Time              string   `form:"time"`
+	EndDate           string   `form:"end_date"`
+	EndTime           string   `form:"end_time"`
+	TimeZone          string   `form:"time_zone"`
 	IsConfirmed       string   `form:"is_confirmed"`
 	IsParticipant     string   `form:"is_participant"`
+	IsPublic          string   `form:"is_public"`
 	DetailTitle       string   `form:"detail_title"`
 	DetailPerson      string   `form:"detail_person"`
 	ParticipantTitles []string `form:"participant_titles[]"`
 	ParticipantPhones []string `form:"participant_phones[]"`
 	ParticipantCounts []int    `form:"participant_counts[]"`
+	PrimaryLocale     string   `form:"primary_locale" validate:"omitempty,oneof=tr en de"`
+
+	// Dil seçicideki sırayla, her dil için birer değer gönderilir
+	TranslationLocales      []string `form:"translation_locales"`
+	TranslationTitles       []string `form:"translation_titles"`
+	TranslationDescriptions []string `form:"translation_descriptions"`
+	TranslationNotes        []string `form:"translation_notes"`
+	TranslationVenues       []string `form:"translation_venues"`
+	TranslationDetailTitles []string `form:"translation_detail_titles"`
 }
 
 func ValidateInvitationRequest(c *fiber.Ctx) error {
@@ -41,10 +63,102 @@ func ValidateInvitationRequest(c *fiber.Ctx) error {
 		"CategoryID_gt":          "Kategori seçimi zorunludur",
 		"Title_required":         "Başlık zorunludur",
 		"Title_min":              "Başlık en az 2 karakter olmalıdır",
+		"PrimaryLocale_oneof":    "Geçersiz dil seçimi",
 	}
-	if err := validateRequest(c, &req, errorMessages, "/dashboard/invitations/create"); err != nil {
+	// Hem panel hem dashboard oluşturma/güncelleme rotalarında kullanıldığından formun kendisine dönülür
+	if err := validateRequest(c, &req, errorMessages, c.Path()); err != nil {
 		return err
 	}
+	var schedule InvitationSchedule
+	var err error
+	if schedule.StartsAt, schedule.EndsAt, schedule.AllDay, err = req.Schedule(); err != nil {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, err.Error())
+		return c.Redirect(c.Path(), fiber.StatusSeeOther)
+	}
 	c.Locals("invitationRequest", req)
+	c.Locals("invitationSchedule", schedule)
 	return c.Next()
 }
+
+// InvitationSchedule, ValidateInvitationRequest'in formdan çözümlediği etkinlik zamanıdır.
+type InvitationSchedule struct {
+	StartsAt time.Time
+	EndsAt   *time.Time
+	AllDay   bool
+}
+
+// Zone, formda seçilen saat dilimini döndürür; seçim yoksa uygulamanın saat dilimi kullanılır.
+func (r InvitationRequest) Zone() (*time.Location, error) {
+	if strings.TrimSpace(r.TimeZone) == "" {
+		return envconfig.GetLocation(), nil
+	}
+	return eventtime.LoadLocation(r.TimeZone)
+}
+
+// Schedule, formdaki tarih ve saatleri davetiyenin saat diliminde çözümler.
+// Tarih "21.06.2025" veya "21 Haziran 2025" gibi Türkçe yazılabilir; saat girilmezse etkinlik tüm gün sürer.
+// Bitiş tarihi boşsa başlangıç günü kullanılır, bitiş saati başlangıçtan önceyse gece yarısını geçtiği kabul edilir.
+// Tarih girilmemişse sıfır zaman döner.
+func (r InvitationRequest) Schedule() (startsAt time.Time, endsAt *time.Time, allDay bool, err error) {
+	loc, err := r.Zone()
+	if err != nil {
+		return time.Time{}, nil, false, err
+	}
+	if strings.TrimSpace(r.Date) == "" {
+		return time.Time{}, nil, false, nil
+	}
+	startsAt, allDay, err = eventtime.At(r.Date, r.Time, loc)
+	if err != nil {
+		return time.Time{}, nil, false, err
+	}
+
+	endDate := r.EndDate
+	if strings.TrimSpace(endDate) == "" {
+		if allDay || strings.TrimSpace(r.EndTime) == "" {
+			return startsAt, nil, allDay, nil
+		}
+		endDate = r.Date
+	}
+	endClock := r.EndTime
+	if allDay {
+		endClock = ""
+	}
+	end, _, err := eventtime.At(endDate, endClock, loc)
+	if err != nil {
+		return time.Time{}, nil, false, err
+	}
+	if !allDay && strings.TrimSpace(r.EndDate) == "" && !end.After(startsAt) {
+		end = end.AddDate(0, 0, 1)
+	}
+	// Tüm gün etkinlikleri aynı gün bitebilir; saatli etkinliklerde bitiş başlangıçtan sonra olmalıdır
+	if end.Before(startsAt) || !allDay && end.Equal(startsAt) {
+		return time.Time{}, nil, false, errors.New("bitiş zamanı başlangıçtan sonra olmalıdır")
+	}
+	return startsAt, &end, allDay, nil
+}
+
+// InvitationTranslations, formdaki çeviri alanlarını dillere göre gruplar.
+// Formda çeviri alanı yoksa nil döner ve kayıtlı çeviriler olduğu gibi bırakılır.
+func (r InvitationRequest) InvitationTranslations() []models.InvitationTranslation {
+	if r.TranslationLocales == nil {
+		return nil
+	}
+	at := func(values []string, i int) string {
+		if i < len(values) {
+			return strings.TrimSpace(values[i])
+		}
+		return ""
+	}
+	translations := make([]models.InvitationTranslation, 0, len(r.TranslationLocales))
+	for i, locale := range r.TranslationLocales {
+		translations = append(translations, models.InvitationTranslation{
+			Locale:      locale,
+			Title:       at(r.TranslationTitles, i),
+			Description: at(r.TranslationDescriptions, i),
+			Note:        at(r.TranslationNotes, i),
+			Venue:       at(r.TranslationVenues, i),
+			DetailTitle: at(r.TranslationDetailTitles, i),
+		})
+	}
+	return translations
+}
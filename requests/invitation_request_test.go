@@ -0,0 +1,124 @@
+package requests
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInvitationRequestSchedule(t *testing.T) {
+	istanbul, err := time.LoadLocation("Europe/Istanbul")
+	if err != nil {
+		t.Fatalf("Europe/Istanbul yüklenemedi: %v", err)
+	}
+	at := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, istanbul)
+	}
+	tests := []struct {
+		name   string
+		req    InvitationRequest
+		start  time.Time
+		end    *time.Time
+		allDay bool
+	}{
+		{
+			name: "tarih yok",
+			req:  InvitationRequest{TimeZone: "Europe/Istanbul"},
+		},
+		{
+			name:  "yalnızca başlangıç",
+			req:   InvitationRequest{Date: "21.06.2025", Time: "19:30", TimeZone: "Europe/Istanbul"},
+			start: at(2025, 6, 21, 19, 30),
+		},
+		{
+			name:  "aynı gün bitiş",
+			req:   InvitationRequest{Date: "21 Haziran 2025", Time: "19:30", EndTime: "23:00", TimeZone: "Europe/Istanbul"},
+			start: at(2025, 6, 21, 19, 30),
+			end:   ptr(at(2025, 6, 21, 23, 0)),
+		},
+		{
+			name:  "gece yarısını geçen bitiş",
+			req:   InvitationRequest{Date: "21.06.2025", Time: "20:00", EndTime: "02:00", TimeZone: "Europe/Istanbul"},
+			start: at(2025, 6, 21, 20, 0),
+			end:   ptr(at(2025, 6, 22, 2, 0)),
+		},
+		{
+			name:  "bitiş saati başlangıca eşitse ertesi gün",
+			req:   InvitationRequest{Date: "21.06.2025", Time: "20:00", EndTime: "20:00", TimeZone: "Europe/Istanbul"},
+			start: at(2025, 6, 21, 20, 0),
+			end:   ptr(at(2025, 6, 22, 20, 0)),
+		},
+		{
+			name:  "bitiş tarihi ve saati",
+			req:   InvitationRequest{Date: "21.06.2025", Time: "20:00", EndDate: "23.06.2025", EndTime: "12:00", TimeZone: "Europe/Istanbul"},
+			start: at(2025, 6, 21, 20, 0),
+			end:   ptr(at(2025, 6, 23, 12, 0)),
+		},
+		{
+			name:   "tüm gün",
+			req:    InvitationRequest{Date: "21.06.2025", TimeZone: "Europe/Istanbul"},
+			start:  at(2025, 6, 21, 0, 0),
+			allDay: true,
+		},
+		{
+			name:   "tüm gün bitiş saati yok sayılır",
+			req:    InvitationRequest{Date: "21.06.2025", EndTime: "18:00", TimeZone: "Europe/Istanbul"},
+			start:  at(2025, 6, 21, 0, 0),
+			allDay: true,
+		},
+		{
+			name:   "birkaç gün süren tüm gün",
+			req:    InvitationRequest{Date: "21.06.2025", EndDate: "23.06.2025", EndTime: "18:00", TimeZone: "Europe/Istanbul"},
+			start:  at(2025, 6, 21, 0, 0),
+			end:    ptr(at(2025, 6, 23, 0, 0)),
+			allDay: true,
+		},
+		{
+			name:   "aynı gün biten tüm gün",
+			req:    InvitationRequest{Date: "21.06.2025", EndDate: "21.06.2025", TimeZone: "Europe/Istanbul"},
+			start:  at(2025, 6, 21, 0, 0),
+			end:    ptr(at(2025, 6, 21, 0, 0)),
+			allDay: true,
+		},
+	}
+	for _, tt := range tests {
+		start, end, allDay, err := tt.req.Schedule()
+		if err != nil {
+			t.Errorf("%s: hata = %v", tt.name, err)
+			continue
+		}
+		if !start.Equal(tt.start) || allDay != tt.allDay {
+			t.Errorf("%s: başlangıç = %v (tüm gün %v), %v (tüm gün %v) bekleniyordu", tt.name, start, allDay, tt.start, tt.allDay)
+		}
+		switch {
+		case tt.end == nil && end != nil:
+			t.Errorf("%s: bitiş = %v, nil bekleniyordu", tt.name, *end)
+		case tt.end != nil && (end == nil || !end.Equal(*tt.end)):
+			t.Errorf("%s: bitiş = %v, %v bekleniyordu", tt.name, end, *tt.end)
+		}
+	}
+}
+
+func TestInvitationRequestScheduleRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		req  InvitationRequest
+	}{
+		{"geçersiz tarih", InvitationRequest{Date: "31.02.2025", Time: "19:30"}},
+		{"geçersiz saat", InvitationRequest{Date: "21.06.2025", Time: "19:75"}},
+		{"geçersiz bitiş saati", InvitationRequest{Date: "21.06.2025", Time: "19:30", EndTime: "yarın"}},
+		{"geçersiz saat dilimi", InvitationRequest{Date: "21.06.2025", TimeZone: "Europe/Ankara"}},
+		{"bitiş başlangıçtan önce", InvitationRequest{Date: "21.06.2025", Time: "20:00", EndDate: "21.06.2025", EndTime: "19:00", TimeZone: "UTC"}},
+		{"bitiş başlangıca eşit", InvitationRequest{Date: "21.06.2025", Time: "20:00", EndDate: "21.06.2025", EndTime: "20:00", TimeZone: "UTC"}},
+		{"bitiş günü başlangıçtan önce", InvitationRequest{Date: "21.06.2025", Time: "20:00", EndDate: "20.06.2025", EndTime: "23:00", TimeZone: "UTC"}},
+		{"tüm gün bitişi başlangıçtan önce", InvitationRequest{Date: "21.06.2025", EndDate: "20.06.2025", TimeZone: "UTC"}},
+	}
+	for _, tt := range tests {
+		if _, _, _, err := tt.req.Schedule(); err == nil {
+			t.Errorf("%s: hata bekleniyordu", tt.name)
+		}
+	}
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
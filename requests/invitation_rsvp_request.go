@@ -0,0 +1,57 @@
+package requests
+
+import (
+	"strings"
+	"time"
+
+	"davet.link/pkg/eventtime"
+	"davet.link/pkg/flashmessages"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Katılım bildirimi ayarları: kontenjan, refakatçi sınırı ve son bildirim tarihi
+type InvitationRSVPRequest struct {
+	MaxHeadcount  int    `form:"max_headcount" validate:"min=0,max=100000"`
+	MaxCompanions int    `form:"max_companions" validate:"min=0,max=19"`
+	DeadlineDate  string `form:"deadline_date"`
+	DeadlineTime  string `form:"deadline_time"`
+}
+
+func ValidateInvitationRSVPRequest(c *fiber.Ctx) error {
+	var req InvitationRSVPRequest
+	errorMessages := map[string]string{
+		"MaxHeadcount_min":  "Kontenjan 0'dan küçük olamaz",
+		"MaxHeadcount_max":  "Kontenjan en fazla 100000 olabilir",
+		"MaxCompanions_min": "Refakatçi sınırı 0'dan küçük olamaz",
+		"MaxCompanions_max": "Refakatçi sınırı en fazla 19 olabilir",
+	}
+	redirectPath := "/panel/invitations/rsvp/" + c.Params("id")
+	if err := validateRequest(c, &req, errorMessages, redirectPath); err != nil {
+		return err
+	}
+	// Saat dilimi henüz bilinmediğinden yalnızca yazım denetlenir; zaman handler'da davetiyenin diliminde çözümlenir
+	if _, err := req.Deadline(time.UTC); err != nil {
+		_ = flashmessages.SetFlashMessage(c, flashmessages.FlashErrorKey, err.Error())
+		return c.Redirect(redirectPath, fiber.StatusSeeOther)
+	}
+	c.Locals("invitationRSVPRequest", req)
+	return c.Next()
+}
+
+// Deadline, son bildirim zamanını davetiyenin saat diliminde çözümler; tarih boşsa nil döner.
+// Saat girilmezse günün sonu (23:59) kabul edilir.
+func (r InvitationRSVPRequest) Deadline(loc *time.Location) (*time.Time, error) {
+	if strings.TrimSpace(r.DeadlineDate) == "" {
+		return nil, nil
+	}
+	clock := strings.TrimSpace(r.DeadlineTime)
+	if clock == "" {
+		clock = "23:59"
+	}
+	deadline, _, err := eventtime.At(r.DeadlineDate, clock, loc)
+	if err != nil {
+		return nil, err
+	}
+	return &deadline, nil
+}
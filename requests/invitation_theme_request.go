@@ -0,0 +1,21 @@
+package requests
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type InvitationThemeRequest struct {
+	Theme string `form:"theme" validate:"required"`
+}
+
+func ValidateInvitationThemeRequest(c *fiber.Ctx) error {
+	var req InvitationThemeRequest
+	errorMessages := map[string]string{
+		"Theme_required": "Tema seçimi zorunludur",
+	}
+	if err := validateRequest(c, &req, errorMessages, c.Path()); err != nil {
+		return err
+	}
+	c.Locals("invitationThemeRequest", req)
+	return c.Next()
+}
@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type PageRequest struct {
+	Slug            string `form:"slug" validate:"required,max=100"`
+	Title           string `form:"title" validate:"required,min=2,max=255"`
+	Body            string `form:"body"`
+	MetaTitle       string `form:"meta_title" validate:"max=255"`
+	MetaDescription string `form:"meta_description" validate:"max=300"`
+	IsPublished     string `form:"is_published"`
+}
+
+func ValidatePageRequest(c *fiber.Ctx) error {
+	var req PageRequest
+	errorMessages := map[string]string{
+		"Slug_required":       "Sayfa adresi zorunludur",
+		"Slug_max":            "Sayfa adresi en fazla 100 karakter olabilir",
+		"Title_required":      "Başlık zorunludur",
+		"Title_min":           "Başlık en az 2 karakter olmalıdır",
+		"Title_max":           "Başlık en fazla 255 karakter olabilir",
+		"MetaTitle_max":       "SEO başlığı en fazla 255 karakter olabilir",
+		"MetaDescription_max": "SEO açıklaması en fazla 300 karakter olabilir",
+	}
+	// Hata durumunda aynı formun GET adresine dönülür (oluşturma veya düzenleme)
+	if err := validateRequest(c, &req, errorMessages, c.Path()); err != nil {
+		return err
+	}
+	c.Locals("pageRequest", req)
+	return c.Next()
+}
@@ -0,0 +1,38 @@
+package requests
+
+import (
+	"davet.link/pkg/i18n"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type QRCodeRequest struct {
+	Format   string `query:"format" validate:"omitempty,oneof=png svg"`
+	Size     int    `query:"size" validate:"omitempty,min=128,max=2048"`
+	Level    string `query:"level" validate:"omitempty,oneof=L M Q H l m q h"`
+	Logo     bool   `query:"logo"`
+	Download bool   `query:"download"`
+}
+
+func ValidateQRCodeRequest(c *fiber.Ctx) error {
+	var req QRCodeRequest
+	errorMessages := map[string]string{
+		"Format_oneof": "Geçersiz format, png veya svg olmalıdır",
+		"Size_min":     "Boyut en az 128 piksel olmalıdır",
+		"Size_max":     "Boyut en fazla 2048 piksel olabilir",
+		"Level_oneof":  "Hata düzeltme seviyesi L, M, Q veya H olmalıdır",
+	}
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString(i18n.Translate(c, "Geçersiz istek formatı"))
+	}
+	if err := validator.New().Struct(&req); err != nil {
+		err := err.(validator.ValidationErrors)[0]
+		if msg, ok := errorMessages[err.Field()+"_"+err.Tag()]; ok {
+			return c.Status(fiber.StatusBadRequest).SendString(i18n.Translate(c, msg))
+		}
+		return c.Status(fiber.StatusBadRequest).SendString(i18n.Translate(c, "Geçersiz parametre"))
+	}
+	c.Locals("qrCodeRequest", req)
+	return c.Next()
+}
@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// Katılım formuna eklenen soru; seçenekli sorularda seçenekler satır satır yazılır
+type RSVPQuestionRequest struct {
+	Label    string `form:"label" validate:"required,min=2,max=255"`
+	Type     string `form:"type" validate:"required,oneof=text single_choice multi_choice number"`
+	Options  string `form:"options" validate:"max=3000"`
+	Required bool   `form:"required"`
+}
+
+type RSVPQuestionMoveRequest struct {
+	Direction string `form:"direction" validate:"required,oneof=up down"`
+}
+
+func ValidateRSVPQuestionRequest(c *fiber.Ctx) error {
+	var req RSVPQuestionRequest
+	errorMessages := map[string]string{
+		"Label_required": "Soru zorunludur",
+		"Label_min":      "Soru en az 2 karakter olmalıdır",
+		"Label_max":      "Soru en fazla 255 karakter olabilir",
+		"Type_required":  "Soru türü zorunludur",
+		"Type_oneof":     "Geçersiz soru türü",
+		"Options_max":    "Seçenekler en fazla 3000 karakter olabilir",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/panel/invitations/questions/"+c.Params("id")); err != nil {
+		return err
+	}
+	c.Locals("rsvpQuestionRequest", req)
+	return c.Next()
+}
+
+func ValidateRSVPQuestionMoveRequest(c *fiber.Ctx) error {
+	var req RSVPQuestionMoveRequest
+	errorMessages := map[string]string{
+		"Direction_required": "Geçersiz taşıma yönü",
+		"Direction_oneof":    "Geçersiz taşıma yönü",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/panel/invitations/questions/"+c.Params("id")); err != nil {
+		return err
+	}
+	c.Locals("rsvpQuestionMoveRequest", req)
+	return c.Next()
+}
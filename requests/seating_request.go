@@ -0,0 +1,60 @@
+package requests
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// Oturma planına eklenen masa; kapasite masada oturabilecek kişi sayısıdır
+type SeatingTableRequest struct {
+	Name     string `form:"name" validate:"required,min=1,max=50"`
+	Capacity int    `form:"capacity" validate:"required,min=1,max=100"`
+}
+
+// Katılımcının masası (0 ise masasız) ve refakatçilerinin masaları. Refakatçiler için companion_id ve
+// companion_table aynı sırayla gönderilir; 0 refakatçinin katılımcıyla oturduğunu belirtir.
+type SeatAssignmentRequest struct {
+	TableID         uint   `form:"table_id"`
+	CompanionIDs    []uint `form:"companion_id" validate:"max=19"`
+	CompanionTables []uint `form:"companion_table" validate:"max=19"`
+}
+
+// Companions, refakatçi kimliklerini atandıkları masalarla eşler.
+func (r SeatAssignmentRequest) Companions() map[uint]uint {
+	tables := make(map[uint]uint, len(r.CompanionIDs))
+	for i, id := range r.CompanionIDs {
+		if i < len(r.CompanionTables) {
+			tables[id] = r.CompanionTables[i]
+		}
+	}
+	return tables
+}
+
+func ValidateSeatingTableRequest(c *fiber.Ctx) error {
+	var req SeatingTableRequest
+	errorMessages := map[string]string{
+		"Name_required":     "Masa adı zorunludur",
+		"Name_min":          "Masa adı zorunludur",
+		"Name_max":          "Masa adı en fazla 50 karakter olabilir",
+		"Capacity_required": "Masa kapasitesi zorunludur",
+		"Capacity_min":      "Masa kapasitesi en az 1 olmalıdır",
+		"Capacity_max":      "Masa kapasitesi en fazla 100 olabilir",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/panel/invitations/seating/"+c.Params("id")); err != nil {
+		return err
+	}
+	c.Locals("seatingTableRequest", req)
+	return c.Next()
+}
+
+func ValidateSeatAssignmentRequest(c *fiber.Ctx) error {
+	var req SeatAssignmentRequest
+	errorMessages := map[string]string{
+		"CompanionIDs_max":    "Geçersiz refakatçi ataması",
+		"CompanionTables_max": "Geçersiz refakatçi ataması",
+	}
+	if err := validateRequest(c, &req, errorMessages, "/panel/invitations/seating/"+c.Params("id")); err != nil {
+		return err
+	}
+	c.Locals("seatAssignmentRequest", req)
+	return c.Next()
+}
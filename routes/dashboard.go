@@ -4,6 +4,7 @@ import (
 	handlers "davet.link/handlers/dashboard"
 	"davet.link/middlewares"
 	"davet.link/models"
+	"davet.link/requests"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -62,9 +63,19 @@ func registerDashboardRoutes(app *fiber.App) {
 	invitationHandler := handlers.NewDashboardInvitationHandler()
 	dashboardGroup.Get("/invitations", invitationHandler.ListInvitations)
 	dashboardGroup.Get("/invitations/create", invitationHandler.ShowCreateInvitation)
-	dashboardGroup.Post("/invitations/create", invitationHandler.CreateInvitation)
+	dashboardGroup.Post("/invitations/create", requests.ValidateInvitationRequest, invitationHandler.CreateInvitation)
 	dashboardGroup.Get("/invitations/update/:id", invitationHandler.ShowUpdateInvitation)
-	dashboardGroup.Post("/invitations/update/:id", invitationHandler.UpdateInvitation)
+	dashboardGroup.Post("/invitations/update/:id", requests.ValidateInvitationRequest, invitationHandler.UpdateInvitation)
 	dashboardGroup.Delete("/invitations/delete/:id", invitationHandler.DeleteInvitation)
 	dashboardGroup.Get("/invitations/participants/:id", invitationHandler.ListParticipants)
+	dashboardGroup.Get("/invitations/participants/:id/export", invitationHandler.ExportParticipantsCSV)
+	dashboardGroup.Get("/invitations/participants/:id/print", invitationHandler.ExportParticipantsPDF)
+
+	pageHandler := handlers.NewDashboardPageHandler()
+	dashboardGroup.Get("/pages", pageHandler.ListPages)
+	dashboardGroup.Get("/pages/create", pageHandler.ShowCreatePage)
+	dashboardGroup.Post("/pages/create", requests.ValidatePageRequest, pageHandler.CreatePage)
+	dashboardGroup.Get("/pages/update/:id", pageHandler.ShowUpdatePage)
+	dashboardGroup.Post("/pages/update/:id", requests.ValidatePageRequest, pageHandler.UpdatePage)
+	dashboardGroup.Delete("/pages/delete/:id", pageHandler.DeletePage)
 }
@@ -1,13 +1,30 @@
 package routes
 
 import (
+	"regexp"
+
+	"davet.link/configs/sessionconfig"
 	handlers "davet.link/handlers/panel"
 	"davet.link/middlewares"
 	"davet.link/models"
+	"davet.link/requests"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// galleryUploadPath, galeri yükleme rotasıyla (POST /panel/invitations/gallery/:id) eşleşir.
+var galleryUploadPath = regexp.MustCompile(`^/panel/invitations/gallery/[0-9]+/?$`)
+
+// IsUploadRequest, oturum açmış kullanıcının galeri yüklemesini tanır; yalnızca bu istekler büyük gövdeyle kabul edilir.
+// Gövde sınırı rota ara katmanlarından önce uygulandığından oturum burada ayrıca denetlenir.
+func IsUploadRequest(c *fiber.Ctx) bool {
+	if c.Method() != fiber.MethodPost || !galleryUploadPath.MatchString(c.Path()) {
+		return false
+	}
+	userID, err := sessionconfig.GetUserIDFromSession(c)
+	return err == nil && userID > 0
+}
+
 func registerPanelRoutes(app *fiber.App) {
 	panelGroup := app.Group("/panel")
 	panelGroup.Use(
@@ -26,13 +43,69 @@ func registerPanelRoutes(app *fiber.App) {
 	panelGroup.Get("/cards/update/:id", panelCardHandler.ShowUpdateCard)
 	panelGroup.Post("/cards/update/:id", panelCardHandler.UpdateCard)
 	panelGroup.Delete("/cards/delete/:id", panelCardHandler.DeleteCard)
+	panelGroup.Get("/cards/qr/:id", requests.ValidateQRCodeRequest, panelCardHandler.CardQRCode)
+	panelGroup.Get("/cards/stats/:id", panelCardHandler.CardStats)
 
 	panelInvitationHandler := handlers.NewPanelInvitationHandler()
 	panelGroup.Get("/invitations", panelInvitationHandler.ListInvitations)
 	panelGroup.Get("/invitations/create", panelInvitationHandler.ShowCreateInvitation)
-	panelGroup.Post("/invitations/create", panelInvitationHandler.CreateInvitation)
+	panelGroup.Post("/invitations/create", requests.ValidateInvitationRequest, panelInvitationHandler.CreateInvitation)
 	panelGroup.Get("/invitations/update/:id", panelInvitationHandler.ShowUpdateInvitation)
-	panelGroup.Post("/invitations/update/:id", panelInvitationHandler.UpdateInvitation)
+	panelGroup.Post("/invitations/update/:id", requests.ValidateInvitationRequest, panelInvitationHandler.UpdateInvitation)
 	panelGroup.Delete("/invitations/delete/:id", panelInvitationHandler.DeleteInvitation)
 	panelGroup.Get("/invitations/participants/:id", panelInvitationHandler.ListParticipants)
+	panelGroup.Get("/invitations/participants/:id/export", panelInvitationHandler.ExportParticipantsCSV)
+	panelGroup.Get("/invitations/participants/:id/print", panelInvitationHandler.ExportParticipantsPDF)
+	panelGroup.Get("/invitations/qr/:id", requests.ValidateQRCodeRequest, panelInvitationHandler.InvitationQRCode)
+	panelGroup.Get("/invitations/stats/:id", panelInvitationHandler.InvitationStats)
+	panelGroup.Get("/invitations/theme/:id", panelInvitationHandler.ShowInvitationTheme)
+	panelGroup.Post("/invitations/theme/:id", requests.ValidateInvitationThemeRequest, panelInvitationHandler.UpdateInvitationTheme)
+	panelGroup.Get("/themes/preview/:name", panelInvitationHandler.PreviewTheme)
+	panelGroup.Get("/invitations/rsvp/:id", panelInvitationHandler.ShowRSVPSettings)
+	panelGroup.Post("/invitations/rsvp/:id", requests.ValidateInvitationRSVPRequest, panelInvitationHandler.UpdateRSVPSettings)
+
+	panelGuestbookHandler := handlers.NewPanelGuestbookHandler()
+	panelGroup.Get("/invitations/guestbook/:id", panelGuestbookHandler.ListEntries)
+	panelGroup.Get("/invitations/guestbook/:id/export", panelGuestbookHandler.ExportEntries)
+	panelGroup.Post("/invitations/guestbook/:id/status/:entryID", requests.ValidateGuestbookStatusRequest, panelGuestbookHandler.UpdateEntryStatus)
+	panelGroup.Post("/invitations/guestbook/:id/delete/:entryID", panelGuestbookHandler.DeleteEntry)
+
+	panelInvitationMediaHandler := handlers.NewPanelInvitationMediaHandler()
+	panelGroup.Get("/invitations/gallery/:id", panelInvitationMediaHandler.ShowGallery)
+	panelGroup.Post("/invitations/gallery/:id", panelInvitationMediaHandler.UploadMedia)
+	panelGroup.Post("/invitations/gallery/:id/caption/:mediaID", requests.ValidateInvitationMediaCaptionRequest, panelInvitationMediaHandler.UpdateCaption)
+	panelGroup.Post("/invitations/gallery/:id/move/:mediaID", requests.ValidateInvitationMediaMoveRequest, panelInvitationMediaHandler.MoveMedia)
+	panelGroup.Post("/invitations/gallery/:id/delete/:mediaID", panelInvitationMediaHandler.DeleteMedia)
+
+	panelInvitationEventHandler := handlers.NewPanelInvitationEventHandler()
+	panelGroup.Get("/invitations/programme/:id", panelInvitationEventHandler.ShowProgramme)
+	panelGroup.Post("/invitations/programme/:id", requests.ValidateInvitationEventRequest, panelInvitationEventHandler.CreateEvent)
+	panelGroup.Post("/invitations/programme/:id/update/:eventID", requests.ValidateInvitationEventRequest, panelInvitationEventHandler.UpdateEvent)
+	panelGroup.Post("/invitations/programme/:id/delete/:eventID", panelInvitationEventHandler.DeleteEvent)
+
+	panelRSVPQuestionHandler := handlers.NewPanelRSVPQuestionHandler()
+	panelGroup.Get("/invitations/questions/:id", panelRSVPQuestionHandler.ShowQuestions)
+	panelGroup.Post("/invitations/questions/:id", requests.ValidateRSVPQuestionRequest, panelRSVPQuestionHandler.CreateQuestion)
+	panelGroup.Post("/invitations/questions/:id/update/:questionID", requests.ValidateRSVPQuestionRequest, panelRSVPQuestionHandler.UpdateQuestion)
+	panelGroup.Post("/invitations/questions/:id/move/:questionID", requests.ValidateRSVPQuestionMoveRequest, panelRSVPQuestionHandler.MoveQuestion)
+	panelGroup.Post("/invitations/questions/:id/delete/:questionID", panelRSVPQuestionHandler.DeleteQuestion)
+
+	panelSeatingHandler := handlers.NewPanelSeatingHandler()
+	panelGroup.Get("/invitations/seating/:id", panelSeatingHandler.ShowSeating)
+	panelGroup.Get("/invitations/seating/:id/print", panelSeatingHandler.PrintSeating)
+	panelGroup.Post("/invitations/seating/:id", requests.ValidateSeatingTableRequest, panelSeatingHandler.CreateTable)
+	panelGroup.Post("/invitations/seating/:id/update/:tableID", requests.ValidateSeatingTableRequest, panelSeatingHandler.UpdateTable)
+	panelGroup.Post("/invitations/seating/:id/delete/:tableID", panelSeatingHandler.DeleteTable)
+	panelGroup.Post("/invitations/seating/:id/assign/:participantID", requests.ValidateSeatAssignmentRequest, panelSeatingHandler.AssignSeats)
+
+	panelGuestHandler := handlers.NewPanelGuestHandler()
+	panelGroup.Get("/invitations/guests/:id", panelGuestHandler.ListGuests)
+	panelGroup.Post("/invitations/guests/:id", requests.ValidateGuestRequest, panelGuestHandler.CreateGuest)
+	panelGroup.Post("/invitations/guests/:id/update/:guestID", requests.ValidateGuestRequest, panelGuestHandler.UpdateGuest)
+	panelGroup.Post("/invitations/guests/:id/delete/:guestID", panelGuestHandler.DeleteGuest)
+
+	panelGuestImportHandler := handlers.NewPanelGuestImportHandler()
+	panelGroup.Get("/invitations/guests/:id/import", panelGuestImportHandler.ShowImport)
+	panelGroup.Post("/invitations/guests/:id/import", panelGuestImportHandler.PreviewImport)
+	panelGroup.Post("/invitations/guests/:id/import/confirm", requests.ValidateGuestImportRequest, panelGuestImportHandler.ConfirmImport)
 }
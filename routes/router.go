@@ -1,8 +1,12 @@
 package routes
 
 import (
+	"strings"
+
 	"davet.link/configs/limiterconfig"
 	"davet.link/middlewares"
+	"davet.link/pkg/slugs"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/limiter"
 )
@@ -12,10 +16,27 @@ func SetupRoutes(app *fiber.App) {
 
 	app.Use(middlewares.SessionMiddleware())
 
+	app.Use(middlewares.LocaleMiddleware())
+
 	app.Use(middlewares.ZapLogger())
 
+	app.Use(middlewares.NoIndexMiddleware())
+
 	registerWebsiteRoutes(app)
 	registerAuthRoutes(app)
 	registerDashboardRoutes(app)
 	registerPanelRoutes(app)
+
+	reserveRoutePrefixes(app)
+}
+
+// reserveRoutePrefixes, sabit rotaların ilk yol parçasını (ör: /panel, /robots.txt) adres olarak kullanıma kapatır.
+func reserveRoutePrefixes(app *fiber.App) {
+	for _, route := range app.GetRoutes(true) {
+		segment := strings.SplitN(strings.TrimPrefix(route.Path, "/"), "/", 2)[0]
+		if segment == "" || strings.ContainsAny(segment, ":*+") {
+			continue
+		}
+		slugs.Reserve(segment)
+	}
 }
@@ -1,19 +1,37 @@
 package routes
 
 import (
+	"davet.link/configs/limiterconfig"
 	handlers "davet.link/handlers/website"
+	"davet.link/requests"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
 )
 
 func registerWebsiteRoutes(app *fiber.App) {
 	websiteHandler := handlers.NewWebsiteHandler()
 	app.Get("/", websiteHandler.ShowHomePage)
 	app.Get("/kullanim-sartlari", websiteHandler.ShowTermsOfUse)
-	// Statik sayfalar için tek bir route
-	app.Get("/:staticPageName", websiteHandler.ShowStaticPage)
-	// Davetiye rotası (ör: /123asd1)
-	app.Get("/:invitationKey", websiteHandler.ShowInvitation)
-	// Kartvizit rotası (ör: /@serhan)
-	app.Get("/@:cardSlug", websiteHandler.ShowCard)
+	// Arama motorları (ör: /sitemap.xml, /sitemaps/cards-2.xml)
+	app.Get("/robots.txt", websiteHandler.ShowRobots)
+	app.Get("/sitemap.xml", websiteHandler.ShowSitemap)
+	app.Get("/sitemaps/:section-:page.xml", websiteHandler.ShowSitemapPage)
+	// Kartvizit rehber dosyası (ör: /@serhan.vcf)
+	app.Get("/@:cardSlug.vcf", websiteHandler.DownloadCardVCard)
+	// Takvim rotaları (ör: /calendar/<token>.ics, /123asd1.ics)
+	app.Get("/calendar/:calendarToken.ics", websiteHandler.DownloadUserCalendar)
+	app.Get("/:invitationKey.ics", websiteHandler.DownloadInvitationCalendar)
+	// Paylaşım önizleme görselleri (ör: /og/invitation/123asd1.png, /og/card/serhan.png)
+	app.Get("/og/invitation/:invitationKey.png", websiteHandler.ShowInvitationImage)
+	app.Get("/og/card/:cardSlug.png", websiteHandler.ShowCardImage)
+	// Sayfa, davetiye ve kartvizitler tek adres çözümleyicisinden geçer (ör: /hakkimizda, /123asd1, /@serhan)
+	app.Get("/:slug", websiteHandler.ShowSlug)
+	// Katılım bildirimi (oturum gerektirmez)
+	app.Post("/:invitationKey", requests.ValidateRSVPRequest, websiteHandler.SubmitRSVP)
+	// Davetiye defterine mesaj (ör: /123asd1/guestbook)
+	app.Post("/:invitationKey/guestbook",
+		limiter.New(limiterconfig.GetGuestbookLimiterConfig(websiteHandler.GuestbookLimitReached)),
+		requests.ValidateGuestbookRequest,
+		websiteHandler.SubmitGuestbookEntry)
 }
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"davet.link/configs/envconfig"
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrAnalyticsGeneric ServiceError = "istatistikler alınırken bir hata oluştu"
+)
+
+const (
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+	DeviceDesktop = "desktop"
+
+	AnalyticsDefaultDays = 30
+	AnalyticsMaxDays     = 365
+
+	analyticsBufferSize    = 4096
+	analyticsBatchSize     = 200
+	analyticsFlushInterval = 10 * time.Second
+	analyticsTopReferrers  = 10
+	// Ziyaretçi özetinin saklanan uzunluğu (hex)
+	visitorHashLength = 32
+)
+
+// Bağlantı önizlemesi yapan servisler ve tarayıcı olmayan istemciler sayılmaz
+var botUserAgentMarkers = []string{
+	"bot", "crawl", "spider", "slurp", "preview", "facebookexternalhit", "whatsapp",
+	"viber", "headless", "lighthouse", "curl", "wget", "python-requests", "go-http-client",
+}
+
+type PageViewInput struct {
+	Target    models.PageViewTarget
+	TargetID  uint
+	IP        string
+	UserAgent string
+	Referrer  string
+}
+
+type PageViewStats struct {
+	Days          int
+	TotalViews    int64
+	TotalVisitors int64
+	// Görüntülenmesi olmayan günler sıfırla doldurulur
+	Daily     []repositories.PageViewDailyCount
+	Referrers []repositories.PageViewGroupCount
+	Devices   []repositories.PageViewGroupCount
+}
+
+type IAnalyticsService interface {
+	// RecordPageView, görüntülenmeyi belleğe alır; kayıtlar toplu olarak veritabanına yazılır.
+	RecordPageView(input PageViewInput)
+	GetPageViewStats(target models.PageViewTarget, targetID uint, days int) (*PageViewStats, error)
+	// Flush, bekleyen kayıtları hemen yazar (uygulama kapanırken çağrılır).
+	Flush()
+}
+
+type AnalyticsService struct {
+	repo     repositories.IPageViewRepository
+	secret   []byte
+	events   chan models.PageView
+	flushReq chan chan struct{}
+
+	startOnce sync.Once
+}
+
+var analyticsService = &AnalyticsService{}
+
+func NewAnalyticsService() IAnalyticsService {
+	analyticsService.startOnce.Do(analyticsService.start)
+	return analyticsService
+}
+
+func (s *AnalyticsService) start() {
+	s.repo = repositories.NewPageViewRepository()
+	s.events = make(chan models.PageView, analyticsBufferSize)
+	s.flushReq = make(chan chan struct{})
+
+	s.secret = []byte(os.Getenv("ANALYTICS_SECRET"))
+	if len(s.secret) == 0 {
+		s.secret = make([]byte, 32)
+		_, _ = rand.Read(s.secret)
+		logconfig.Log.Warn("ANALYTICS_SECRET tanımlı değil, tekil ziyaretçi sayımı yeniden başlatmada sıfırlanacak")
+	}
+
+	go s.run()
+}
+
+func (s *AnalyticsService) RecordPageView(input PageViewInput) {
+	if IsBotUserAgent(input.UserAgent) {
+		return
+	}
+
+	// Tarih sütunu saat dilimsiz olduğundan yerel gün UTC gece yarısı olarak saklanır
+	now := time.Now().In(envconfig.GetLocation())
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	view := models.PageView{
+		TargetType:   input.Target,
+		TargetID:     input.TargetID,
+		Day:          day,
+		VisitorHash:  s.visitorHash(day, input.IP, input.UserAgent),
+		ReferrerHost: referrerHost(input.Referrer),
+		DeviceClass:  deviceClass(input.UserAgent),
+		CreatedAt:    now,
+	}
+
+	select {
+	case s.events <- view:
+	default:
+		logconfig.Log.Warn("Görüntülenme arabelleği dolu, kayıt atlandı",
+			zap.String("target", string(input.Target)), zap.Uint("target_id", input.TargetID))
+	}
+}
+
+func (s *AnalyticsService) Flush() {
+	done := make(chan struct{})
+	s.flushReq <- done
+	<-done
+}
+
+func (s *AnalyticsService) run() {
+	ticker := time.NewTicker(analyticsFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]models.PageView, 0, analyticsBatchSize)
+	write := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := s.repo.CreatePageViews(batch); err != nil {
+			logconfig.Log.Error("Görüntülenmeler kaydedilemedi", zap.Int("count", len(batch)), zap.Error(err))
+		}
+		batch = make([]models.PageView, 0, analyticsBatchSize)
+	}
+
+	for {
+		select {
+		case view := <-s.events:
+			batch = append(batch, view)
+			if len(batch) >= analyticsBatchSize {
+				write()
+			}
+		case <-ticker.C:
+			write()
+		case done := <-s.flushReq:
+			for drained := false; !drained; {
+				select {
+				case view := <-s.events:
+					batch = append(batch, view)
+				default:
+					drained = true
+				}
+			}
+			write()
+			close(done)
+		}
+	}
+}
+
+func (s *AnalyticsService) GetPageViewStats(target models.PageViewTarget, targetID uint, days int) (*PageViewStats, error) {
+	if days <= 0 || days > AnalyticsMaxDays {
+		days = AnalyticsDefaultDays
+	}
+	now := time.Now().In(envconfig.GetLocation())
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	since := today.AddDate(0, 0, -(days - 1))
+
+	stats := &PageViewStats{Days: days}
+	var err error
+	if stats.TotalViews, stats.TotalVisitors, err = s.repo.GetPageViewTotals(target, targetID); err != nil {
+		return nil, s.statsError(target, targetID, err)
+	}
+
+	daily, err := s.repo.GetDailyPageViews(target, targetID, since)
+	if err != nil {
+		return nil, s.statsError(target, targetID, err)
+	}
+	byDay := make(map[string]repositories.PageViewDailyCount, len(daily))
+	for _, d := range daily {
+		byDay[d.Day.Format("2006-01-02")] = d
+	}
+	for day := since; !day.After(today); day = day.AddDate(0, 0, 1) {
+		count := byDay[day.Format("2006-01-02")]
+		count.Day = day
+		stats.Daily = append(stats.Daily, count)
+	}
+
+	if stats.Referrers, err = s.repo.GetPageViewReferrers(target, targetID, since, analyticsTopReferrers); err != nil {
+		return nil, s.statsError(target, targetID, err)
+	}
+	if stats.Devices, err = s.repo.GetPageViewDevices(target, targetID, since); err != nil {
+		return nil, s.statsError(target, targetID, err)
+	}
+	return stats, nil
+}
+
+func (s *AnalyticsService) statsError(target models.PageViewTarget, targetID uint, err error) error {
+	logconfig.Log.Error("Görüntülenme istatistikleri alınamadı",
+		zap.String("target", string(target)), zap.Uint("target_id", targetID), zap.Error(err))
+	return ErrAnalyticsGeneric
+}
+
+// visitorHash, IP ve tarayıcıyı güne özel anahtarla özetler; farklı günlerin özetleri birbiriyle eşleştirilemez.
+func (s *AnalyticsService) visitorHash(day time.Time, ip, userAgent string) string {
+	daySalt := hmac.New(sha256.New, s.secret)
+	daySalt.Write([]byte(day.Format("2006-01-02")))
+
+	mac := hmac.New(sha256.New, daySalt.Sum(nil))
+	mac.Write([]byte(ip))
+	mac.Write([]byte{0})
+	mac.Write([]byte(userAgent))
+	return hex.EncodeToString(mac.Sum(nil))[:visitorHashLength]
+}
+
+// IsBotUserAgent, isteğin tarayıcı dışı bir istemciden ya da bağlantı önizlemesi yapan bir servisten geldiğini döndürür.
+func IsBotUserAgent(userAgent string) bool {
+	ua := strings.ToLower(userAgent)
+	if ua == "" {
+		return true
+	}
+	for _, marker := range botUserAgentMarkers {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func deviceClass(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	switch {
+	case strings.Contains(ua, "ipad") || strings.Contains(ua, "tablet") ||
+		(strings.Contains(ua, "android") && !strings.Contains(ua, "mobile")):
+		return DeviceTablet
+	case strings.Contains(ua, "mobi") || strings.Contains(ua, "iphone") || strings.Contains(ua, "android"):
+		return DeviceMobile
+	}
+	return DeviceDesktop
+}
+
+// referrerHost, yalnızca yönlendiren sitenin alan adını döndürür; site içi ve doğrudan girişler boş kalır.
+func referrerHost(referrer string) string {
+	u, err := url.Parse(strings.TrimSpace(referrer))
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if base, err := url.Parse(envconfig.GetBaseURL()); err == nil {
+		if host == strings.TrimPrefix(strings.ToLower(base.Hostname()), "www.") {
+			return ""
+		}
+	}
+	return host
+}
+
+var _ IAnalyticsService = (*AnalyticsService)(nil)
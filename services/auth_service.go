@@ -47,6 +47,7 @@ type IAuthService interface {
 	VerifyEmail(token string) error
 	ResendVerificationLink(email string) error
 	FindOrCreateUser(user models.User) (*models.User, error)
+	UpdateLocale(ctx context.Context, userID uint, locale string) error
 }
 
 type AuthService struct {
@@ -178,6 +179,24 @@ func (s *AuthService) UpdatePassword(ctx context.Context, userID uint, currentPa
 	return nil
 }
 
+// UpdateLocale, kullanıcının arayüz dili tercihini kaydeder; tercih girişte oturuma aktarılır.
+func (s *AuthService) UpdateLocale(ctx context.Context, userID uint, locale string) error {
+	user, err := s.getUserByID(userID)
+	if err != nil {
+		return err
+	}
+	if user.Locale == locale {
+		return nil
+	}
+
+	user.Locale = locale
+	if err := s.repo.UpdateUser(ctx, user); err != nil {
+		s.logDBError("Dil tercihi güncelleme", err, zap.Uint("user_id", userID))
+		return ErrDatabaseUpdateFailed
+	}
+	return nil
+}
+
 func (s *AuthService) CreateUser(ctx context.Context, user *models.User) error {
 	if user.Password == "" {
 		return errors.New("şifre alanı boş olamaz")
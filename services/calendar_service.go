@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	"davet.link/configs/envconfig"
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/ical"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrCalendarNotFound    ServiceError = "takvim bulunamadı"
+	ErrInvitationDateEmpty ServiceError = "davetiyenin tarihi belirtilmemiş"
+	ErrCalendarGeneric     ServiceError = "takvim oluşturulurken bir hata oluştu"
+)
+
+const (
+	defaultEventDuration   = 3 * time.Hour
+	defaultEventAlarm      = 24 * time.Hour
+	calendarRefreshPeriod  = 6 * time.Hour
+	invitationEventUIDHost = "davet.link"
+)
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+type ICalendarService interface {
+	GetInvitationCalendar(invitationKey string) ([]byte, error)
+	GetUserCalendarFeed(token string) ([]byte, error)
+	GetOrCreateCalendarToken(ctx context.Context, userID uint) (string, error)
+}
+
+type CalendarService struct {
+	invitationRepo repositories.IInvitationRepository
+	eventRepo      repositories.IInvitationEventRepository
+	userRepo       repositories.IUserRepository
+}
+
+func NewCalendarService() ICalendarService {
+	return &CalendarService{
+		invitationRepo: repositories.NewInvitationRepository(),
+		eventRepo:      repositories.NewInvitationEventRepository(),
+		userRepo:       repositories.NewUserRepository(),
+	}
+}
+
+func (s *CalendarService) GetInvitationCalendar(invitationKey string) ([]byte, error) {
+	invitation, err := s.invitationRepo.GetConfirmedInvitationByKey(invitationKey)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+		logconfig.Log.Error("Takvim için davetiye alınamadı", zap.String("invitation_key", invitationKey), zap.Error(err))
+		return nil, ErrCalendarGeneric
+	}
+
+	programme, err := s.eventRepo.GetEventsByInvitationID(invitation.ID)
+	if err != nil {
+		logconfig.Log.Error("Takvim için davetiye programı alınamadı", zap.Uint("invitation_id", invitation.ID), zap.Error(err))
+		return nil, ErrCalendarGeneric
+	}
+	events := invitationEvents(invitation, programme)
+	if len(events) == 0 {
+		return nil, ErrInvitationDateEmpty
+	}
+	return ical.Calendar{
+		Name:   invitationSummary(invitation),
+		Events: events,
+	}.Encode(), nil
+}
+
+func (s *CalendarService) GetUserCalendarFeed(token string) ([]byte, error) {
+	user, err := s.userRepo.GetUserByCalendarToken(token)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCalendarNotFound
+		}
+		logconfig.Log.Error("Takvim aboneliği için kullanıcı alınamadı", zap.Error(err))
+		return nil, ErrCalendarGeneric
+	}
+
+	invitations, err := s.invitationRepo.GetInvitationsByUserID(user.ID)
+	if err != nil {
+		logconfig.Log.Error("Takvim aboneliği için davetiyeler alınamadı", zap.Uint("user_id", user.ID), zap.Error(err))
+		return nil, ErrCalendarGeneric
+	}
+
+	invitationIDs := make([]uint, len(invitations))
+	for i := range invitations {
+		invitationIDs[i] = invitations[i].ID
+	}
+	programmes, err := s.eventRepo.GetEventsByInvitationIDs(invitationIDs)
+	if err != nil {
+		logconfig.Log.Error("Takvim aboneliği için davetiye programları alınamadı", zap.Uint("user_id", user.ID), zap.Error(err))
+		return nil, ErrCalendarGeneric
+	}
+
+	events := make([]ical.Event, 0, len(invitations))
+	for i := range invitations {
+		events = append(events, invitationEvents(&invitations[i], programmes[invitations[i].ID])...)
+	}
+	return ical.Calendar{
+		Name:            "davet.link - " + user.Name,
+		Description:     "davet.link üzerinde oluşturduğunuz davetiyeler",
+		RefreshInterval: calendarRefreshPeriod,
+		Events:          events,
+	}.Encode(), nil
+}
+
+func (s *CalendarService) GetOrCreateCalendarToken(ctx context.Context, userID uint) (string, error) {
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		logconfig.Log.Error("Takvim anahtarı için kullanıcı alınamadı", zap.Uint("user_id", userID), zap.Error(err))
+		return "", ErrCalendarGeneric
+	}
+	if user.CalendarToken != "" {
+		return user.CalendarToken, nil
+	}
+
+	token := generateToken() + generateToken()
+	if len(token) != 64 {
+		return "", ErrCalendarGeneric
+	}
+	if err := s.userRepo.UpdateUser(ctx, userID, map[string]interface{}{"calendar_token": token}, userID); err != nil {
+		logconfig.Log.Error("Takvim anahtarı kaydedilemedi", zap.Uint("user_id", userID), zap.Error(err))
+		return "", ErrCalendarGeneric
+	}
+	return token, nil
+}
+
+// invitationEvents, programı olan davetiyenin her bölümünü ayrı etkinlik olarak döndürür.
+// Programı olmayan davetiye tek etkinlik olarak eklenir.
+func invitationEvents(invitation *models.Invitation, programme []models.InvitationEvent) []ical.Event {
+	if len(programme) == 0 {
+		if event, ok := invitationEvent(invitation); ok {
+			return []ical.Event{event}
+		}
+		return nil
+	}
+
+	pageURL := envconfig.GetBaseURL() + "/" + invitation.InvitationKey
+	events := make([]ical.Event, 0, len(programme))
+	for _, item := range programme {
+		lastModified := item.UpdatedAt
+		if invitation.UpdatedAt.After(lastModified) {
+			lastModified = invitation.UpdatedAt
+		}
+		event := ical.Event{
+			UID:          fmt.Sprintf("invitation-%d-event-%d@%s", invitation.ID, item.ID, invitationEventUIDHost),
+			Summary:      joinNonEmpty(" - ", invitationSummary(invitation), item.Name),
+			Description:  invitationDescription(invitation, pageURL),
+			Location:     joinNonEmpty(", ", item.Venue, item.Address),
+			URL:          pageURL,
+			Start:        item.StartsAt,
+			End:          item.StartsAt.Add(defaultEventDuration),
+			Created:      item.CreatedAt,
+			LastModified: lastModified,
+			Alarm:        defaultEventAlarm,
+		}
+		if item.EndsAt != nil {
+			event.End = *item.EndsAt
+		}
+		if event.Location == "" {
+			event.Location = item.Location
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// invitationEvent, davetiyeyi takvim etkinliğine çevirir; tarihi olmayan davetiyeler atlanır.
+func invitationEvent(invitation *models.Invitation) (ical.Event, bool) {
+	if invitation.StartsAt.IsZero() {
+		return ical.Event{}, false
+	}
+
+	loc := InvitationLocation(invitation)
+	pageURL := envconfig.GetBaseURL() + "/" + invitation.InvitationKey
+	event := ical.Event{
+		UID:          fmt.Sprintf("invitation-%d@%s", invitation.ID, invitationEventUIDHost),
+		Summary:      invitationSummary(invitation),
+		Description:  invitationDescription(invitation, pageURL),
+		Location:     joinNonEmpty(", ", invitation.Venue, invitation.Address),
+		URL:          pageURL,
+		Created:      invitation.CreatedAt,
+		LastModified: invitation.UpdatedAt,
+		Alarm:        defaultEventAlarm,
+	}
+	if event.Location == "" {
+		event.Location = invitation.Location
+	}
+
+	if invitation.AllDay {
+		// Tüm gün etkinlikleri saat diliminden bağımsız takvim günleriyle yazılır; bitiş günü dahil değildir
+		start := invitation.StartsAt.In(loc)
+		event.AllDay = true
+		event.Start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+		event.End = event.Start.AddDate(0, 0, 1)
+		if invitation.EndsAt != nil {
+			end := invitation.EndsAt.In(loc)
+			if last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1); last.After(event.End) {
+				event.End = last
+			}
+		}
+		return event, true
+	}
+
+	event.Start = invitation.StartsAt
+	event.End = event.Start.Add(defaultEventDuration)
+	if invitation.EndsAt != nil && invitation.EndsAt.After(event.Start) {
+		event.End = *invitation.EndsAt
+	}
+	return event, true
+}
+
+func invitationSummary(invitation *models.Invitation) string {
+	if invitation.Title != "" {
+		return invitation.Title
+	}
+	if invitation.InvitationDetail != nil && invitation.InvitationDetail.Title != "" {
+		return invitation.InvitationDetail.Title
+	}
+	return "Davetiye"
+}
+
+func invitationDescription(invitation *models.Invitation, pageURL string) string {
+	description := strings.TrimSpace(html.UnescapeString(htmlTagPattern.ReplaceAllString(invitation.Description, "")))
+	return joinNonEmpty("\n\n", description, strings.TrimSpace(invitation.Note), pageURL)
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+var _ ICalendarService = (*CalendarService)(nil)
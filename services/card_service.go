@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"davet.link/configs/databaseconfig"
 	"davet.link/configs/logconfig"
@@ -13,9 +14,15 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	ErrCardNotFound ServiceError = "kartvizit bulunamadı"
+	ErrCardGeneric  ServiceError = "kartvizit getirilirken bir hata oluştu"
+)
+
 type ICardService interface {
 	GetAllCards(params queryparams.ListParams) (*queryparams.PaginatedResult, error)
 	GetCardByID(ctx context.Context, id uint) (*models.Card, error)
+	GetPublicCardBySlug(slug string) (*models.Card, error)
 	CreateCard(ctx context.Context, card *models.Card) error
 	UpdateCard(ctx context.Context, id uint, card *models.Card) error
 	DeleteCard(ctx context.Context, id uint) error
@@ -23,11 +30,15 @@ type ICardService interface {
 }
 
 type CardService struct {
-	repo repositories.ICardRepository
+	repo        repositories.ICardRepository
+	slugService ISlugService
 }
 
 func NewCardService() ICardService {
-	return &CardService{repo: repositories.NewCardRepository()}
+	return &CardService{
+		repo:        repositories.NewCardRepository(),
+		slugService: NewSlugService(),
+	}
 }
 
 func (s *CardService) GetAllCards(params queryparams.ListParams) (*queryparams.PaginatedResult, error) {
@@ -53,7 +64,23 @@ func (s *CardService) GetCardByID(ctx context.Context, id uint) (*models.Card, e
 	return s.repo.GetCardByID(id)
 }
 
+func (s *CardService) GetPublicCardBySlug(slug string) (*models.Card, error) {
+	card, err := s.repo.GetActiveCardBySlug(slug)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCardNotFound
+		}
+		logconfig.Log.Error("Kartvizit slug ile alınamadı", zap.String("slug", slug), zap.Error(err))
+		return nil, ErrCardGeneric
+	}
+	return card, nil
+}
+
 func (s *CardService) CreateCard(ctx context.Context, card *models.Card) error {
+	card.Slug = strings.TrimSpace(card.Slug)
+	if err := s.slugService.EnsureAvailable(SlugKindCard, card.Slug, 0); err != nil {
+		return err
+	}
 	// Card ve ilişkili junction tabloları transaction ile ekle
 	db, ok := ctx.Value("db").(*gorm.DB)
 	if !ok || db == nil {
@@ -78,6 +105,10 @@ func (s *CardService) CreateCard(ctx context.Context, card *models.Card) error {
 }
 
 func (s *CardService) UpdateCard(ctx context.Context, id uint, card *models.Card) error {
+	card.Slug = strings.TrimSpace(card.Slug)
+	if err := s.slugService.EnsureAvailable(SlugKindCard, card.Slug, id); err != nil {
+		return err
+	}
 	db, ok := ctx.Value("db").(*gorm.DB)
 	if !ok || db == nil {
 		db = databaseconfig.GetDB()
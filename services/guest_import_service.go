@@ -0,0 +1,341 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/csvimport"
+	"davet.link/pkg/vcard"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrGuestImportFileType ServiceError = "yalnızca .csv ve .vcf dosyaları içe aktarılabilir"
+	ErrGuestImportTooLarge ServiceError = "dosya en fazla 2 MB ve 2000 satır olabilir"
+	ErrGuestImportEmpty    ServiceError = "dosyada içe aktarılacak kişi bulunamadı"
+	ErrGuestImportInvalid  ServiceError = "dosya okunamadı; lütfen CSV veya vCard biçimini kontrol edin"
+	ErrGuestImportColumns  ServiceError = "geçersiz sütun seçimi"
+	ErrGuestImportGeneric  ServiceError = "davetliler içe aktarılırken bir hata oluştu"
+)
+
+const (
+	maxGuestImportBytes = 2 << 20
+	// Önizlemede gösterilen satır sayısı
+	guestImportSampleRows = 10
+)
+
+// İçe aktarma raporunda satırların neden atlandığı; şablonda çevrilir.
+const (
+	guestImportReasonNoName       = "Ad Soyad boş"
+	guestImportReasonLongName     = "Ad Soyad en fazla 255 karakter olabilir"
+	guestImportReasonInvalidPhone = "Geçersiz telefon numarası"
+	guestImportReasonExisting     = "Davet listesinde zaten var"
+	guestImportReasonRepeated     = "Dosyada birden fazla kez geçiyor"
+)
+
+// Başlık satırındaki sütun adlarından ad ve telefon sütunları tahmin edilir
+var (
+	guestNameHeaders    = []string{"ad soyad", "adı soyadı", "ad", "adı", "isim", "isim soyisim", "name", "full name", "vorname"}
+	guestSurnameHeaders = []string{"soyad", "soyadı", "soyisim", "surname", "last name", "nachname"}
+	guestPhoneHeaders   = []string{"telefon", "telefon numarası", "tel", "cep", "cep telefonu", "gsm", "phone", "mobile", "telefonnummer", "handy"}
+)
+
+// GuestColumnMapping, CSV sütunlarının davetli alanlarına eşlenmesidir; -1 sütunun kullanılmadığını belirtir.
+type GuestColumnMapping struct {
+	NameColumn    int
+	SurnameColumn int
+	PhoneColumn   int
+	HasHeader     bool
+}
+
+// GuestImportPreview, yüklenen dosyanın sütun eşleme adımında gösterilen özetidir.
+type GuestImportPreview struct {
+	Source  string // "csv" veya "vcf"
+	Columns []string
+	Sample  [][]string
+	// Tüm satırlar; onay adımına CSV olarak taşınır
+	Data      string
+	TotalRows int
+	Mapping   GuestColumnMapping
+}
+
+type GuestImportIssue struct {
+	Line   int
+	Name   string
+	Phone  string
+	Reason string
+}
+
+type GuestImportResult struct {
+	Imported   int
+	Duplicates []GuestImportIssue
+	Failed     []GuestImportIssue
+}
+
+type IGuestImportService interface {
+	// PreviewFile, CSV veya vCard dosyasını okuyup sütun eşleme önerisiyle birlikte önizleme döndürür.
+	PreviewFile(filename string, size int64, r io.Reader) (*GuestImportPreview, error)
+	// ImportGuests, önizlemedeki satırları tek bir işlemde davet listesine ekler; tekrar eden ve hatalı satırları raporlar.
+	ImportGuests(ctx context.Context, invitationID uint, data string, mapping GuestColumnMapping) (*GuestImportResult, error)
+}
+
+type GuestImportService struct {
+	repo repositories.IGuestRepository
+}
+
+func NewGuestImportService() IGuestImportService {
+	return &GuestImportService{repo: repositories.NewGuestRepository()}
+}
+
+func (s *GuestImportService) PreviewFile(filename string, size int64, r io.Reader) (*GuestImportPreview, error) {
+	if size > maxGuestImportBytes {
+		return nil, ErrGuestImportTooLarge
+	}
+	r = io.LimitReader(r, maxGuestImportBytes)
+
+	var rows [][]string
+	preview := &GuestImportPreview{}
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".vcf", ".vcard":
+		cards, err := vcard.Decode(r)
+		if err != nil {
+			return nil, ErrGuestImportInvalid
+		}
+		if len(cards) > maxGuests {
+			return nil, ErrGuestImportTooLarge
+		}
+		for _, card := range cards {
+			rows = append(rows, []string{card.FullName, card.Telephone})
+		}
+		preview.Source = "vcf"
+		preview.Columns = []string{"Ad Soyad", "Telefon"}
+		preview.Mapping = GuestColumnMapping{NameColumn: 0, SurnameColumn: -1, PhoneColumn: 1}
+	case ".csv", ".txt":
+		var err error
+		rows, err = csvimport.Read(r, maxGuests+1)
+		switch {
+		case errors.Is(err, csvimport.ErrTooManyRows):
+			return nil, ErrGuestImportTooLarge
+		case errors.Is(err, csvimport.ErrEmpty):
+			return nil, ErrGuestImportEmpty
+		case err != nil:
+			return nil, ErrGuestImportInvalid
+		}
+		preview.Source = "csv"
+		preview.Columns, preview.Mapping = guessGuestColumns(rows)
+	default:
+		return nil, ErrGuestImportFileType
+	}
+	if len(rows) == 0 {
+		return nil, ErrGuestImportEmpty
+	}
+
+	var data strings.Builder
+	if err := csvimport.Write(&data, rows); err != nil {
+		logconfig.Log.Error("İçe aktarma önizlemesi hazırlanamadı", zap.Error(err))
+		return nil, ErrGuestImportGeneric
+	}
+	preview.Data = data.String()
+	preview.TotalRows = len(rows)
+	if preview.Mapping.HasHeader {
+		preview.TotalRows--
+		rows = rows[1:]
+	}
+	preview.Sample = rows[:min(len(rows), guestImportSampleRows)]
+	return preview, nil
+}
+
+func (s *GuestImportService) ImportGuests(ctx context.Context, invitationID uint, data string, mapping GuestColumnMapping) (*GuestImportResult, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil || len(rows) == 0 {
+		return nil, ErrGuestImportInvalid
+	}
+	if err := validateGuestMapping(rows, mapping); err != nil {
+		return nil, err
+	}
+
+	firstLine := 1
+	if mapping.HasHeader {
+		rows = rows[1:]
+		firstLine = 2
+	}
+
+	result := &GuestImportResult{}
+	err = s.repo.ImportGuests(ctx, invitationID, func(existing []models.Guest) ([]models.Guest, error) {
+		known := make(map[string]bool, len(existing))
+		for _, guest := range existing {
+			known[guestImportKey(guest)] = true
+		}
+		seen := make(map[string]bool)
+
+		var guests []models.Guest
+		for i, row := range rows {
+			guest, issue := guestFromRow(row, mapping)
+			issue.Line = firstLine + i
+			if issue.Reason != "" {
+				result.Failed = append(result.Failed, issue)
+				continue
+			}
+			key := guestImportKey(guest)
+			if known[key] || seen[key] {
+				issue.Reason = guestImportReasonExisting
+				if seen[key] {
+					issue.Reason = guestImportReasonRepeated
+				}
+				result.Duplicates = append(result.Duplicates, issue)
+				continue
+			}
+			seen[key] = true
+
+			token, err := generateGuestToken()
+			if err != nil {
+				return nil, err
+			}
+			guest.InvitationID = invitationID
+			guest.Token = token
+			guests = append(guests, guest)
+		}
+		if len(existing)+len(guests) > maxGuests {
+			return nil, ErrGuestLimit
+		}
+		result.Imported = len(guests)
+		return guests, nil
+	})
+	if err != nil {
+		var serviceErr ServiceError
+		if errors.As(err, &serviceErr) {
+			return nil, err
+		}
+		logconfig.Log.Error("Davetliler içe aktarılamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, ErrGuestImportGeneric
+	}
+	return result, nil
+}
+
+// guessGuestColumns, ilk satır bilinen bir sütun adı içeriyorsa onu başlık kabul eder ve eşlemeyi başlıktan çıkarır.
+// Başlık yoksa ilk sütun ad, en az 10 rakam içeren ilk sütun telefon kabul edilir.
+func guessGuestColumns(rows [][]string) ([]string, GuestColumnMapping) {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+	mapping := GuestColumnMapping{NameColumn: -1, SurnameColumn: -1, PhoneColumn: -1}
+	for i, cell := range rows[0] {
+		header := strings.ToLowerSpecial(unicode.TurkishCase, strings.TrimSpace(cell))
+		switch {
+		case mapping.NameColumn < 0 && slices.Contains(guestNameHeaders, header):
+			mapping.NameColumn = i
+		case mapping.SurnameColumn < 0 && slices.Contains(guestSurnameHeaders, header):
+			mapping.SurnameColumn = i
+		case mapping.PhoneColumn < 0 && slices.Contains(guestPhoneHeaders, header):
+			mapping.PhoneColumn = i
+		}
+	}
+	mapping.HasHeader = mapping.NameColumn >= 0 || mapping.PhoneColumn >= 0
+
+	columns := make([]string, width)
+	for i := range columns {
+		columns[i] = "Sütun " + strconv.Itoa(i+1)
+		if mapping.HasHeader && i < len(rows[0]) && rows[0][i] != "" {
+			columns[i] = rows[0][i]
+		}
+	}
+	if mapping.HasHeader {
+		if mapping.NameColumn < 0 {
+			mapping.NameColumn = 0
+		}
+		return columns, mapping
+	}
+
+	mapping.NameColumn = 0
+	for i, cell := range rows[0] {
+		if i != mapping.NameColumn && looksLikePhone(cell) {
+			mapping.PhoneColumn = i
+			break
+		}
+	}
+	return columns, mapping
+}
+
+func validateGuestMapping(rows [][]string, mapping GuestColumnMapping) error {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+	if mapping.NameColumn < 0 || mapping.NameColumn >= width {
+		return ErrGuestImportColumns
+	}
+	for _, column := range []int{mapping.SurnameColumn, mapping.PhoneColumn} {
+		if column < -1 || column >= width || column == mapping.NameColumn {
+			return ErrGuestImportColumns
+		}
+	}
+	if mapping.PhoneColumn >= 0 && mapping.PhoneColumn == mapping.SurnameColumn {
+		return ErrGuestImportColumns
+	}
+	return nil
+}
+
+// guestFromRow, satırı davetliye çevirir; satır kullanılamıyorsa sebebi issue.Reason'da döner.
+func guestFromRow(row []string, mapping GuestColumnMapping) (models.Guest, GuestImportIssue) {
+	cell := func(i int) string {
+		if i < 0 || i >= len(row) {
+			return ""
+		}
+		return row[i]
+	}
+	name := strings.Join(strings.Fields(cell(mapping.NameColumn)+" "+cell(mapping.SurnameColumn)), " ")
+	phone := strings.TrimSpace(cell(mapping.PhoneColumn))
+	issue := GuestImportIssue{Name: name, Phone: phone}
+
+	switch {
+	case name == "":
+		issue.Reason = guestImportReasonNoName
+	case utf8.RuneCountInString(name) > 255:
+		issue.Reason = guestImportReasonLongName
+	}
+	if issue.Reason != "" {
+		return models.Guest{}, issue
+	}
+
+	guest := models.Guest{Name: name, PhoneNumber: phone}
+	if err := normalizeGuest(&guest); err != nil {
+		issue.Reason = guestImportReasonInvalidPhone
+		return models.Guest{}, issue
+	}
+	return guest, issue
+}
+
+// guestImportKey, tekrar denetiminde kullanılır: telefonu olan davetliler numarayla, olmayanlar adla eşleşir.
+func guestImportKey(guest models.Guest) string {
+	if guest.PhoneNumber != "" {
+		return "tel:" + guest.PhoneNumber
+	}
+	return "ad:" + strings.ToLowerSpecial(unicode.TurkishCase, guest.Name)
+}
+
+func looksLikePhone(value string) bool {
+	digits := 0
+	for _, r := range value {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+	return digits >= 10
+}
+
+var _ IGuestImportService = (*GuestImportService)(nil)
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGuestFromRowNameLength(t *testing.T) {
+	mapping := GuestColumnMapping{NameColumn: 0, SurnameColumn: -1, PhoneColumn: -1}
+	tests := []struct {
+		name   string
+		reason string
+	}{
+		// 255 harf, UTF-8'de 510 bayt tutar
+		{strings.Repeat("ş", 255), ""},
+		{strings.Repeat("ş", 256), guestImportReasonLongName},
+		{strings.Repeat("a", 256), guestImportReasonLongName},
+		{"", guestImportReasonNoName},
+	}
+	for _, tt := range tests {
+		_, issue := guestFromRow([]string{tt.name}, mapping)
+		if issue.Reason != tt.reason {
+			t.Errorf("%d harflik ad: sebep = %q, %q bekleniyordu", len([]rune(tt.name)), issue.Reason, tt.reason)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/phonenumber"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrGuestNotFound ServiceError = "davetli bulunamadı"
+	ErrGuestLimit    ServiceError = "davet listesine en fazla 2000 kişi eklenebilir"
+	ErrGuestGeneric  ServiceError = "davet listesi güncellenirken bir hata oluştu"
+)
+
+const maxGuests = 2000
+
+// GuestSummary, davet listesindeki bağlantı durumlarının sayılarıdır.
+type GuestSummary struct {
+	Total     int
+	NotOpened int
+	Opened    int
+	Responded int
+}
+
+type IGuestService interface {
+	// GetGuests, davet listesini verilen duruma göre süzer; durum boşsa tüm liste döner. Özet her zaman tüm listeyi kapsar.
+	GetGuests(invitationID uint, status models.GuestStatus) ([]models.Guest, *GuestSummary, error)
+	CreateGuest(ctx context.Context, guest *models.Guest) error
+	UpdateGuest(ctx context.Context, invitationID, guestID uint, guest *models.Guest) error
+	DeleteGuest(ctx context.Context, invitationID, guestID uint) error
+	// OpenGuestLink, kişiye özel bağlantıyı açan davetliyi döndürür ve ilk açılışı kaydeder.
+	OpenGuestLink(invitationID uint, token string) (*models.Guest, error)
+	// GetGuestByLink, kişiye özel bağlantıdaki davetliyi açılışı kaydetmeden döndürür.
+	GetGuestByLink(invitationID uint, token string) (*models.Guest, error)
+	// LinkParticipant, bağlantıdan yapılan katılım bildirimini davetliyle eşleştirir.
+	LinkParticipant(invitationID uint, token string, participantID uint) error
+}
+
+type GuestService struct {
+	repo repositories.IGuestRepository
+}
+
+func NewGuestService() IGuestService {
+	return &GuestService{repo: repositories.NewGuestRepository()}
+}
+
+func (s *GuestService) GetGuests(invitationID uint, status models.GuestStatus) ([]models.Guest, *GuestSummary, error) {
+	guests, err := s.repo.GetGuestsByInvitationID(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Davet listesi alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, nil, ErrGuestGeneric
+	}
+
+	summary := &GuestSummary{Total: len(guests)}
+	filtered := guests[:0:0]
+	for _, guest := range guests {
+		switch guest.Status() {
+		case models.GuestResponded:
+			summary.Responded++
+		case models.GuestOpened:
+			summary.Opened++
+		default:
+			summary.NotOpened++
+		}
+		if status == "" || guest.Status() == status {
+			filtered = append(filtered, guest)
+		}
+	}
+	return filtered, summary, nil
+}
+
+func (s *GuestService) CreateGuest(ctx context.Context, guest *models.Guest) error {
+	if err := normalizeGuest(guest); err != nil {
+		return err
+	}
+	count, err := s.repo.CountGuests(guest.InvitationID)
+	if err != nil {
+		logconfig.Log.Error("Davetliler sayılamadı", zap.Uint("invitation_id", guest.InvitationID), zap.Error(err))
+		return ErrGuestGeneric
+	}
+	if count >= maxGuests {
+		return ErrGuestLimit
+	}
+
+	token, err := generateGuestToken()
+	if err != nil {
+		logconfig.Log.Error("Davetli bağlantısı üretilemedi", zap.Error(err))
+		return ErrGuestGeneric
+	}
+	guest.Token = token
+	if err := s.repo.CreateGuest(ctx, guest); err != nil {
+		logconfig.Log.Error("Davetli eklenemedi", zap.Uint("invitation_id", guest.InvitationID), zap.Error(err))
+		return ErrGuestGeneric
+	}
+	return nil
+}
+
+func (s *GuestService) UpdateGuest(ctx context.Context, invitationID, guestID uint, guest *models.Guest) error {
+	if err := s.ensureGuest(invitationID, guestID); err != nil {
+		return err
+	}
+	if err := normalizeGuest(guest); err != nil {
+		return err
+	}
+	data := map[string]interface{}{
+		"name":         guest.Name,
+		"phone_number": guest.PhoneNumber,
+	}
+	if err := s.repo.UpdateGuest(ctx, guestID, data); err != nil {
+		logconfig.Log.Error("Davetli güncellenemedi", zap.Uint("guest_id", guestID), zap.Error(err))
+		return ErrGuestGeneric
+	}
+	return nil
+}
+
+func (s *GuestService) DeleteGuest(ctx context.Context, invitationID, guestID uint) error {
+	if err := s.ensureGuest(invitationID, guestID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteGuest(ctx, guestID); err != nil {
+		logconfig.Log.Error("Davetli silinemedi", zap.Uint("guest_id", guestID), zap.Error(err))
+		return ErrGuestGeneric
+	}
+	return nil
+}
+
+func (s *GuestService) OpenGuestLink(invitationID uint, token string) (*models.Guest, error) {
+	guest, err := s.guestByToken(invitationID, token)
+	if err != nil {
+		return nil, err
+	}
+	if guest.OpenedAt == nil {
+		now := time.Now()
+		// Açılış kaydedilemezse davetli yine de karşılanır
+		if err := s.repo.MarkOpened(guest.ID, now); err != nil {
+			logconfig.Log.Warn("Davetli bağlantısının açılışı kaydedilemedi", zap.Uint("guest_id", guest.ID), zap.Error(err))
+		} else {
+			guest.OpenedAt = &now
+		}
+	}
+	return guest, nil
+}
+
+func (s *GuestService) GetGuestByLink(invitationID uint, token string) (*models.Guest, error) {
+	return s.guestByToken(invitationID, token)
+}
+
+func (s *GuestService) LinkParticipant(invitationID uint, token string, participantID uint) error {
+	guest, err := s.guestByToken(invitationID, token)
+	if err != nil {
+		return err
+	}
+	if guest.OpenedAt == nil {
+		if err := s.repo.MarkOpened(guest.ID, time.Now()); err != nil {
+			logconfig.Log.Warn("Davetli bağlantısının açılışı kaydedilemedi", zap.Uint("guest_id", guest.ID), zap.Error(err))
+		}
+	}
+	if err := s.repo.SetParticipant(guest.ID, participantID); err != nil {
+		logconfig.Log.Error("Katılım bildirimi davetliyle eşleştirilemedi", zap.Uint("guest_id", guest.ID), zap.Error(err))
+		return ErrGuestGeneric
+	}
+	return nil
+}
+
+func (s *GuestService) guestByToken(invitationID uint, token string) (*models.Guest, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, ErrGuestNotFound
+	}
+	guest, err := s.repo.GetGuestByToken(invitationID, token)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrGuestNotFound
+		}
+		logconfig.Log.Error("Davetli bağlantısı alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, ErrGuestGeneric
+	}
+	return guest, nil
+}
+
+// ensureGuest, davetlinin verilen davetiyeye ait olduğunu doğrular.
+func (s *GuestService) ensureGuest(invitationID, guestID uint) error {
+	if _, err := s.repo.GetGuest(invitationID, guestID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrGuestNotFound
+		}
+		logconfig.Log.Error("Davetli alınamadı", zap.Uint("guest_id", guestID), zap.Error(err))
+		return ErrGuestGeneric
+	}
+	return nil
+}
+
+func normalizeGuest(guest *models.Guest) error {
+	guest.Name = strings.Join(strings.Fields(guest.Name), " ")
+	guest.PhoneNumber = strings.TrimSpace(guest.PhoneNumber)
+	if guest.PhoneNumber == "" {
+		return nil
+	}
+	phone, err := phonenumber.Normalize(guest.PhoneNumber)
+	if err != nil {
+		return ErrInvalidPhoneNumber
+	}
+	guest.PhoneNumber = phone
+	return nil
+}
+
+// generateGuestToken, bağlantıda kullanılan ve tahmin edilemeyen 128 bitlik anahtarı üretir.
+func generateGuestToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+var _ IGuestService = (*GuestService)(nil)
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"davet.link/configs/envconfig"
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/i18n"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrGuestbookEntryNotFound  ServiceError = "mesaj bulunamadı"
+	ErrInvalidGuestbookStatus  ServiceError = "geçersiz mesaj durumu"
+	ErrGuestbookGeneric        ServiceError = "mesaj kaydedilirken bir hata oluştu"
+	ErrGuestbookModerateFailed ServiceError = "mesaj güncellenirken bir hata oluştu"
+)
+
+const (
+	// Davetiye sayfasında gösterilen en fazla onaylı mesaj sayısı
+	guestbookPublicLimit = 100
+	// Excel'in CSV dosyasını UTF-8 olarak tanıması için dosya başına yazılır
+	utf8BOM = "\uFEFF"
+)
+
+var guestbookStatusLabels = map[models.GuestbookStatus]string{
+	models.GuestbookPending:  "Onay bekliyor",
+	models.GuestbookApproved: "Onaylandı",
+	models.GuestbookHidden:   "Gizlendi",
+}
+
+type GuestbookCounts struct {
+	Pending  int64
+	Approved int64
+	Hidden   int64
+}
+
+type IGuestbookService interface {
+	// SubmitEntry, davetiye sayfasından gelen mesajı onay bekleyen olarak kaydeder.
+	SubmitEntry(invitationKey string, entry *models.GuestbookEntry) error
+	GetApprovedEntries(invitationID uint) ([]models.GuestbookEntry, error)
+	// GetEntries, panelde moderasyon için mesajları listeler; durum boşsa tümü döner.
+	GetEntries(invitationID uint, status models.GuestbookStatus) ([]models.GuestbookEntry, error)
+	GetCounts(invitationID uint) (*GuestbookCounts, error)
+	SetEntryStatus(ctx context.Context, invitationID, entryID uint, status models.GuestbookStatus) error
+	DeleteEntry(ctx context.Context, invitationID, entryID uint) error
+	// ExportEntries, mesajları Excel'in doğru açabileceği biçimde (UTF-8 BOM) CSV olarak yazar.
+	ExportEntries(w io.Writer, invitationID uint, locale string) error
+}
+
+type GuestbookService struct {
+	repo           repositories.IGuestbookRepository
+	invitationRepo repositories.IInvitationRepository
+}
+
+func NewGuestbookService() IGuestbookService {
+	return &GuestbookService{
+		repo:           repositories.NewGuestbookRepository(),
+		invitationRepo: repositories.NewInvitationRepository(),
+	}
+}
+
+func (s *GuestbookService) SubmitEntry(invitationKey string, entry *models.GuestbookEntry) error {
+	invitation, err := s.invitationRepo.GetConfirmedInvitationByKey(invitationKey)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrInvitationNotFound
+		}
+		logconfig.Log.Error("Mesaj için davetiye alınamadı", zap.String("invitation_key", invitationKey), zap.Error(err))
+		return ErrGuestbookGeneric
+	}
+
+	entry.InvitationID = invitation.ID
+	entry.Name = strings.Join(strings.Fields(entry.Name), " ")
+	entry.Message = strings.TrimSpace(strings.ReplaceAll(entry.Message, "\r\n", "\n"))
+	entry.Status = models.GuestbookPending
+	if err := s.repo.CreateEntry(entry); err != nil {
+		logconfig.Log.Error("Mesaj kaydedilemedi", zap.Uint("invitation_id", invitation.ID), zap.Error(err))
+		return ErrGuestbookGeneric
+	}
+	return nil
+}
+
+func (s *GuestbookService) GetApprovedEntries(invitationID uint) ([]models.GuestbookEntry, error) {
+	entries, err := s.repo.GetEntries(invitationID, models.GuestbookApproved, guestbookPublicLimit)
+	if err != nil {
+		logconfig.Log.Error("Onaylı mesajlar alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, ErrGuestbookGeneric
+	}
+	return entries, nil
+}
+
+func (s *GuestbookService) GetEntries(invitationID uint, status models.GuestbookStatus) ([]models.GuestbookEntry, error) {
+	if status != "" && !validGuestbookStatus(status) {
+		return nil, ErrInvalidGuestbookStatus
+	}
+	entries, err := s.repo.GetEntries(invitationID, status, 0)
+	if err != nil {
+		logconfig.Log.Error("Mesajlar alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, ErrGuestbookGeneric
+	}
+	return entries, nil
+}
+
+func (s *GuestbookService) GetCounts(invitationID uint) (*GuestbookCounts, error) {
+	rows, err := s.repo.GetStatusCounts(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Mesaj sayıları alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, ErrGuestbookGeneric
+	}
+	counts := &GuestbookCounts{}
+	for _, row := range rows {
+		switch row.Status {
+		case models.GuestbookPending:
+			counts.Pending = row.Count
+		case models.GuestbookApproved:
+			counts.Approved = row.Count
+		case models.GuestbookHidden:
+			counts.Hidden = row.Count
+		}
+	}
+	return counts, nil
+}
+
+func (s *GuestbookService) SetEntryStatus(ctx context.Context, invitationID, entryID uint, status models.GuestbookStatus) error {
+	if !validGuestbookStatus(status) {
+		return ErrInvalidGuestbookStatus
+	}
+	if err := s.ensureEntry(invitationID, entryID); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateEntryStatus(ctx, entryID, status); err != nil {
+		logconfig.Log.Error("Mesaj durumu güncellenemedi", zap.Uint("entry_id", entryID), zap.Error(err))
+		return ErrGuestbookModerateFailed
+	}
+	return nil
+}
+
+func (s *GuestbookService) DeleteEntry(ctx context.Context, invitationID, entryID uint) error {
+	if err := s.ensureEntry(invitationID, entryID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteEntry(ctx, entryID); err != nil {
+		logconfig.Log.Error("Mesaj silinemedi", zap.Uint("entry_id", entryID), zap.Error(err))
+		return ErrGuestbookModerateFailed
+	}
+	return nil
+}
+
+func (s *GuestbookService) ExportEntries(w io.Writer, invitationID uint, locale string) error {
+	entries, err := s.GetEntries(invitationID, "")
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	header := []string{i18n.T(locale, "Ad Soyad"), i18n.T(locale, "Mesaj"), i18n.T(locale, "Durum"), i18n.T(locale, "Tarih")}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	loc := envconfig.GetLocation()
+	for _, entry := range entries {
+		record := []string{
+			csvSafe(entry.Name),
+			csvSafe(entry.Message),
+			i18n.T(locale, guestbookStatusLabels[entry.Status]),
+			entry.CreatedAt.In(loc).Format("02.01.2006 15:04"),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ensureEntry, mesajın verilen davetiyeye ait olduğunu doğrular.
+func (s *GuestbookService) ensureEntry(invitationID, entryID uint) error {
+	if _, err := s.repo.GetEntry(invitationID, entryID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrGuestbookEntryNotFound
+		}
+		logconfig.Log.Error("Mesaj alınamadı", zap.Uint("entry_id", entryID), zap.Error(err))
+		return ErrGuestbookModerateFailed
+	}
+	return nil
+}
+
+func validGuestbookStatus(status models.GuestbookStatus) bool {
+	_, ok := guestbookStatusLabels[status]
+	return ok
+}
+
+// csvSafe, hesap tablosu programlarının formül olarak çalıştırabileceği değerleri metne çevirir.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+var _ IGuestbookService = (*GuestbookService)(nil)
@@ -7,11 +7,16 @@ import (
 	"davet.link/configs/logconfig"
 	"davet.link/models"
 	"davet.link/pkg/queryparams"
+	"davet.link/pkg/themes"
 	"davet.link/repositories"
 
 	"go.uber.org/zap"
 )
 
+const (
+	ErrInvalidCategoryTemplate ServiceError = "geçersiz kategori şablonu"
+)
+
 type IInvitationCategoryService interface {
 	GetAllCategories(params queryparams.ListParams) (*queryparams.PaginatedResult, error)
 	GetCategoryByID(id uint) (*models.InvitationCategory, error)
@@ -57,6 +62,9 @@ func (s *InvitationCategoryService) GetCategoryByID(id uint) (*models.Invitation
 }
 
 func (s *InvitationCategoryService) CreateCategory(ctx context.Context, category *models.InvitationCategory) error {
+	if !themes.IsCategory(category.Template) {
+		return ErrInvalidCategoryTemplate
+	}
 	return s.repo.CreateCategory(ctx, category)
 }
 
@@ -65,6 +73,9 @@ func (s *InvitationCategoryService) UpdateCategory(ctx context.Context, id uint,
 	if err != nil {
 		return errors.New("davet kategorisi bulunamadı")
 	}
+	if !themes.IsCategory(categoryData.Template) {
+		return ErrInvalidCategoryTemplate
+	}
 	updateData := map[string]interface{}{
 		"name":      categoryData.Name,
 		"icon":      categoryData.Icon,
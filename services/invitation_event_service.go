@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrInvitationEventNotFound ServiceError = "program bölümü bulunamadı"
+	ErrInvitationEventLimit    ServiceError = "programa en fazla 10 bölüm eklenebilir"
+	ErrInvitationEventTime     ServiceError = "geçersiz program saati"
+	ErrInvitationEventGeneric  ServiceError = "program güncellenirken bir hata oluştu"
+)
+
+const maxInvitationEvents = 10
+
+type IInvitationEventService interface {
+	GetEvents(invitationID uint) ([]models.InvitationEvent, error)
+	CreateEvent(ctx context.Context, event *models.InvitationEvent) error
+	UpdateEvent(ctx context.Context, invitationID, eventID uint, event *models.InvitationEvent) error
+	DeleteEvent(ctx context.Context, invitationID, eventID uint) error
+}
+
+type InvitationEventService struct {
+	repo repositories.IInvitationEventRepository
+}
+
+func NewInvitationEventService() IInvitationEventService {
+	return &InvitationEventService{repo: repositories.NewInvitationEventRepository()}
+}
+
+func (s *InvitationEventService) GetEvents(invitationID uint) ([]models.InvitationEvent, error) {
+	events, err := s.repo.GetEventsByInvitationID(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Davetiye programı alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, ErrInvitationEventGeneric
+	}
+	return events, nil
+}
+
+func (s *InvitationEventService) CreateEvent(ctx context.Context, event *models.InvitationEvent) error {
+	if err := normalizeInvitationEvent(event); err != nil {
+		return err
+	}
+	count, err := s.repo.CountEvents(event.InvitationID)
+	if err != nil {
+		logconfig.Log.Error("Program bölümleri sayılamadı", zap.Uint("invitation_id", event.InvitationID), zap.Error(err))
+		return ErrInvitationEventGeneric
+	}
+	if count >= maxInvitationEvents {
+		return ErrInvitationEventLimit
+	}
+	if err := s.repo.CreateEvent(ctx, event); err != nil {
+		logconfig.Log.Error("Program bölümü eklenemedi", zap.Uint("invitation_id", event.InvitationID), zap.Error(err))
+		return ErrInvitationEventGeneric
+	}
+	return nil
+}
+
+func (s *InvitationEventService) UpdateEvent(ctx context.Context, invitationID, eventID uint, event *models.InvitationEvent) error {
+	if err := s.ensureEvent(invitationID, eventID); err != nil {
+		return err
+	}
+	if err := normalizeInvitationEvent(event); err != nil {
+		return err
+	}
+	data := map[string]interface{}{
+		"name":      event.Name,
+		"starts_at": event.StartsAt,
+		"ends_at":   event.EndsAt,
+		"venue":     event.Venue,
+		"address":   event.Address,
+		"location":  event.Location,
+	}
+	if err := s.repo.UpdateEvent(ctx, eventID, data); err != nil {
+		logconfig.Log.Error("Program bölümü güncellenemedi", zap.Uint("event_id", eventID), zap.Error(err))
+		return ErrInvitationEventGeneric
+	}
+	return nil
+}
+
+func (s *InvitationEventService) DeleteEvent(ctx context.Context, invitationID, eventID uint) error {
+	if err := s.ensureEvent(invitationID, eventID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteEvent(ctx, eventID); err != nil {
+		logconfig.Log.Error("Program bölümü silinemedi", zap.Uint("event_id", eventID), zap.Error(err))
+		return ErrInvitationEventGeneric
+	}
+	return nil
+}
+
+// ensureEvent, bölümün verilen davetiyeye ait olduğunu doğrular.
+func (s *InvitationEventService) ensureEvent(invitationID, eventID uint) error {
+	if _, err := s.repo.GetEvent(invitationID, eventID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrInvitationEventNotFound
+		}
+		logconfig.Log.Error("Program bölümü alınamadı", zap.Uint("event_id", eventID), zap.Error(err))
+		return ErrInvitationEventGeneric
+	}
+	return nil
+}
+
+func normalizeInvitationEvent(event *models.InvitationEvent) error {
+	if event.StartsAt.IsZero() || (event.EndsAt != nil && !event.EndsAt.After(event.StartsAt)) {
+		return ErrInvitationEventTime
+	}
+	event.Name = strings.TrimSpace(event.Name)
+	event.Venue = strings.TrimSpace(event.Venue)
+	event.Address = strings.TrimSpace(event.Address)
+	event.Location = strings.TrimSpace(event.Location)
+	return nil
+}
+
+var _ IInvitationEventService = (*InvitationEventService)(nil)
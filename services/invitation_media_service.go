@@ -0,0 +1,274 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"image/jpeg"
+	"io"
+	"mime/multipart"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"davet.link/configs/fileconfig"
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/imageutil"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrMediaNotFound        ServiceError = "fotoğraf bulunamadı"
+	ErrMediaLimitExceeded   ServiceError = "galeriye en fazla 30 fotoğraf eklenebilir"
+	ErrMediaNoFiles         ServiceError = "yüklenecek fotoğraf seçilmedi"
+	ErrMediaInvalidType     ServiceError = "bu dosya türü desteklenmiyor"
+	ErrMediaTooLarge        ServiceError = "fotoğraf boyutu en fazla 10 MB olabilir"
+	ErrMediaInvalidImage    ServiceError = "dosya okunabilir bir fotoğraf değil"
+	ErrMediaInvalidPosition ServiceError = "fotoğraf bu yöne taşınamaz"
+	ErrMediaGeneric         ServiceError = "galeri güncellenirken bir hata oluştu"
+)
+
+const (
+	// Galeri fotoğraflarının yüklendiği fileconfig içerik türü
+	InvitationMediaContentType = "invitations"
+
+	MediaMoveUp   = "up"
+	MediaMoveDown = "down"
+
+	maxInvitationMedia = 30
+	maxMediaFileSize   = 10 << 20
+	mediaThumbnailSize = 480
+	mediaThumbQuality  = 80
+)
+
+type IInvitationMediaService interface {
+	GetMedia(invitationID uint) ([]models.InvitationMedia, error)
+	// UploadMedia, dosyaları doğrulayıp galerinin sonuna ekler ve eklenen fotoğraf sayısını döndürür.
+	// Dosyalar sırayla işlenir; hata olursa ondan önce eklenenler galeride kalır.
+	UploadMedia(ctx context.Context, invitationID uint, files []*multipart.FileHeader) (int, error)
+	UpdateCaption(ctx context.Context, invitationID, mediaID uint, caption string) error
+	// MoveMedia, fotoğrafı galeride bir önceki veya sonraki fotoğrafla yer değiştirir.
+	MoveMedia(ctx context.Context, invitationID, mediaID uint, direction string) error
+	DeleteMedia(ctx context.Context, invitationID, mediaID uint) error
+}
+
+type InvitationMediaService struct {
+	repo repositories.IInvitationMediaRepository
+}
+
+func NewInvitationMediaService() IInvitationMediaService {
+	return &InvitationMediaService{repo: repositories.NewInvitationMediaRepository()}
+}
+
+func (s *InvitationMediaService) GetMedia(invitationID uint) ([]models.InvitationMedia, error) {
+	media, err := s.repo.GetMediaByInvitationID(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Galeri alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, ErrMediaGeneric
+	}
+	return media, nil
+}
+
+func (s *InvitationMediaService) UploadMedia(ctx context.Context, invitationID uint, files []*multipart.FileHeader) (int, error) {
+	if len(files) == 0 {
+		return 0, ErrMediaNoFiles
+	}
+	for _, file := range files {
+		if !fileconfig.Config.IsExtensionAllowed(InvitationMediaContentType, filepath.Ext(file.Filename)) {
+			return 0, ErrMediaInvalidType
+		}
+		if file.Size > maxMediaFileSize {
+			return 0, ErrMediaTooLarge
+		}
+	}
+
+	count, err := s.repo.CountMedia(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Galeri fotoğrafları sayılamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return 0, ErrMediaGeneric
+	}
+	if int(count)+len(files) > maxInvitationMedia {
+		return 0, ErrMediaLimitExceeded
+	}
+	sortOrder, err := s.repo.NextSortOrder(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Galeri sırası alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return 0, ErrMediaGeneric
+	}
+
+	for i, file := range files {
+		media, err := s.storeFile(invitationID, file)
+		if err != nil {
+			return i, err
+		}
+		media.SortOrder = sortOrder + i
+		if err := s.repo.CreateMedia(ctx, media); err != nil {
+			removeMediaFiles(media)
+			logconfig.Log.Error("Galeri fotoğrafı kaydedilemedi", zap.Uint("invitation_id", invitationID), zap.Error(err))
+			return i, ErrMediaGeneric
+		}
+	}
+	return len(files), nil
+}
+
+func (s *InvitationMediaService) UpdateCaption(ctx context.Context, invitationID, mediaID uint, caption string) error {
+	if _, err := s.getMedia(invitationID, mediaID); err != nil {
+		return err
+	}
+	caption = strings.Join(strings.Fields(caption), " ")
+	if err := s.repo.UpdateCaption(ctx, mediaID, caption); err != nil {
+		logconfig.Log.Error("Fotoğraf açıklaması güncellenemedi", zap.Uint("media_id", mediaID), zap.Error(err))
+		return ErrMediaGeneric
+	}
+	return nil
+}
+
+func (s *InvitationMediaService) MoveMedia(ctx context.Context, invitationID, mediaID uint, direction string) error {
+	media, err := s.GetMedia(invitationID)
+	if err != nil {
+		return err
+	}
+	index := -1
+	for i := range media {
+		if media[i].ID == mediaID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return ErrMediaNotFound
+	}
+
+	target := index - 1
+	if direction == MediaMoveDown {
+		target = index + 1
+	} else if direction != MediaMoveUp {
+		return ErrMediaInvalidPosition
+	}
+	if target < 0 || target >= len(media) {
+		return ErrMediaInvalidPosition
+	}
+
+	first, second := media[index], media[target]
+	// Eski kayıtlarda sıralama değerleri çakışabilir; yer değişimi sıradaki konumlarla yapılır
+	if first.SortOrder == second.SortOrder {
+		first.SortOrder, second.SortOrder = index, target
+	}
+	if err := s.repo.SwapSortOrder(ctx, &first, &second); err != nil {
+		logconfig.Log.Error("Galeri sırası güncellenemedi", zap.Uint("media_id", mediaID), zap.Error(err))
+		return ErrMediaGeneric
+	}
+	return nil
+}
+
+func (s *InvitationMediaService) DeleteMedia(ctx context.Context, invitationID, mediaID uint) error {
+	media, err := s.getMedia(invitationID, mediaID)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteMedia(ctx, mediaID); err != nil {
+		logconfig.Log.Error("Galeri fotoğrafı silinemedi", zap.Uint("media_id", mediaID), zap.Error(err))
+		return ErrMediaGeneric
+	}
+	removeMediaFiles(media)
+	return nil
+}
+
+func (s *InvitationMediaService) getMedia(invitationID, mediaID uint) (*models.InvitationMedia, error) {
+	media, err := s.repo.GetMedia(invitationID, mediaID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrMediaNotFound
+		}
+		logconfig.Log.Error("Galeri fotoğrafı alınamadı", zap.Uint("media_id", mediaID), zap.Error(err))
+		return nil, ErrMediaGeneric
+	}
+	return media, nil
+}
+
+// storeFile, yüklenen dosyayı rastgele adla davetiyenin klasörüne yazar ve küçük resmini üretir.
+// Dosyanın içeriği görsel olarak çözümlenemiyorsa hiçbir şey yazılmaz.
+func (s *InvitationMediaService) storeFile(invitationID uint, file *multipart.FileHeader) (*models.InvitationMedia, error) {
+	src, err := file.Open()
+	if err != nil {
+		logconfig.Log.Error("Yüklenen dosya açılamadı", zap.String("file", file.Filename), zap.Error(err))
+		return nil, ErrMediaGeneric
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(io.LimitReader(src, maxMediaFileSize+1))
+	if err != nil {
+		logconfig.Log.Error("Yüklenen dosya okunamadı", zap.String("file", file.Filename), zap.Error(err))
+		return nil, ErrMediaGeneric
+	}
+	if len(data) > maxMediaFileSize {
+		return nil, ErrMediaTooLarge
+	}
+	img, err := imageutil.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrMediaInvalidImage
+	}
+
+	var thumb bytes.Buffer
+	if err := jpeg.Encode(&thumb, imageutil.Cover(img, mediaThumbnailSize, mediaThumbnailSize), &jpeg.Options{Quality: mediaThumbQuality}); err != nil {
+		logconfig.Log.Error("Küçük resim oluşturulamadı", zap.String("file", file.Filename), zap.Error(err))
+		return nil, ErrMediaGeneric
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		logconfig.Log.Error("Dosya adı üretilemedi", zap.Error(err))
+		return nil, ErrMediaGeneric
+	}
+	folder := strconv.FormatUint(uint64(invitationID), 10)
+	name := hex.EncodeToString(token)
+	fileName := name + strings.ToLower(filepath.Ext(file.Filename))
+	thumbName := name + "-thumb.jpg"
+
+	dir := filepath.Join(fileconfig.Config.GetPath(InvitationMediaContentType), folder)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		logconfig.Log.Error("Galeri klasörü oluşturulamadı", zap.String("dir", dir), zap.Error(err))
+		return nil, ErrMediaGeneric
+	}
+	if err := os.WriteFile(filepath.Join(dir, fileName), data, 0644); err != nil {
+		logconfig.Log.Error("Galeri fotoğrafı yazılamadı", zap.String("dir", dir), zap.Error(err))
+		return nil, ErrMediaGeneric
+	}
+	if err := os.WriteFile(filepath.Join(dir, thumbName), thumb.Bytes(), 0644); err != nil {
+		_ = os.Remove(filepath.Join(dir, fileName))
+		logconfig.Log.Error("Küçük resim yazılamadı", zap.String("dir", dir), zap.Error(err))
+		return nil, ErrMediaGeneric
+	}
+
+	bounds := img.Bounds()
+	urlDir := path.Join(uploadsURLPrefix, InvitationMediaContentType, folder)
+	return &models.InvitationMedia{
+		InvitationID:  invitationID,
+		Path:          path.Join(urlDir, fileName),
+		ThumbnailPath: path.Join(urlDir, thumbName),
+		Width:         bounds.Dx(),
+		Height:        bounds.Dy(),
+	}, nil
+}
+
+// removeMediaFiles, fotoğrafın ve küçük resminin dosyalarını siler; eksik dosyalar yok sayılır.
+func removeMediaFiles(media *models.InvitationMedia) {
+	for _, ref := range []string{media.Path, media.ThumbnailPath} {
+		if !strings.HasPrefix(ref, uploadsURLPrefix) {
+			continue
+		}
+		file := filepath.Join(fileconfig.Config.BasePath, filepath.FromSlash(filepath.Clean("/"+strings.TrimPrefix(ref, uploadsURLPrefix))))
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logconfig.Log.Warn("Galeri dosyası silinemedi", zap.String("path", file), zap.Error(err))
+		}
+	}
+}
+
+var _ IInvitationMediaService = (*InvitationMediaService)(nil)
@@ -3,33 +3,130 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"davet.link/configs/databaseconfig"
+	"davet.link/configs/envconfig"
 	"davet.link/configs/logconfig"
 	"davet.link/models"
+	"davet.link/pkg/eventtime"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/phonenumber"
 	"davet.link/pkg/queryparams"
+	"davet.link/pkg/themes"
 	"davet.link/repositories"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
+const (
+	ErrInvitationNotFound  ServiceError = "davetiye bulunamadı"
+	ErrInvitationGeneric   ServiceError = "davetiye getirilirken bir hata oluştu"
+	ErrParticipationClosed ServiceError = "bu davetiye için katılım bildirimi kapalı"
+	ErrInvalidPhoneNumber  ServiceError = "geçersiz telefon numarası"
+	ErrRSVPGeneric         ServiceError = "katılım bildirimi kaydedilirken bir hata oluştu"
+	ErrInvalidTimeZone     ServiceError = "geçersiz saat dilimi"
+	ErrInvitationEndTime   ServiceError = "bitiş zamanı başlangıçtan sonra olmalıdır"
+	ErrInvalidRSVPStatus   ServiceError = "geçersiz katılım yanıtı"
+	ErrRSVPGuestCount      ServiceError = "katılacak kişi sayısı en az 1 olmalıdır"
+	ErrRSVPDeadlinePassed  ServiceError = "katılım bildirimi için son tarih geçti"
+	ErrRSVPCapacity        ServiceError = "kontenjan dolu olduğu için kişi sayısı artırılamıyor"
+	ErrRSVPMaxHeadcount    ServiceError = "kontenjan 0 ile 100000 arasında olmalıdır"
+	ErrRSVPMaxCompanions   ServiceError = "refakatçi sınırı 0 ile 19 arasında olmalıdır"
+	ErrRSVPCompanionLimit  ServiceError = "kişi sayısı davet sahibinin belirlediği sınırı aşıyor"
+	ErrCompanionCount      ServiceError = "refakatçi sayısı, sizinle birlikte gelecek kişi sayısından fazla olamaz"
+	ErrCompanionName       ServiceError = "refakatçi adı 2 ile 100 karakter arasında olmalıdır"
+	ErrCompanionDiet       ServiceError = "beslenme notu en fazla 255 karakter olabilir"
+)
+
+const (
+	// Davetiye başına kabul edilen en yüksek kontenjan
+	MaxRSVPHeadcount = 100000
+	// Katılım formunda kişi sayısı en fazla 20 olduğundan katılımcı başına en fazla 19 refakatçi olabilir
+	MaxRSVPCompanions = 19
+)
+
+var rsvpStatusLabels = map[models.RSVPStatus]string{
+	models.RSVPAttending: "Katılıyor",
+	models.RSVPDeclined:  "Katılmıyor",
+	models.RSVPMaybe:     "Belki",
+	// Yalnızca kontenjan dolduğunda sistem tarafından atanır; davetli bu yanıtı seçemez
+	models.RSVPWaitlisted: "Bekleme listesi",
+}
+
+// ParticipantSummary, katılım bildirimlerinin yanıtlara göre dağılımıdır; kişi sayıları GuestCount toplamıdır.
+type ParticipantSummary struct {
+	Total          int
+	Attending      int
+	Declined       int
+	Maybe          int
+	Headcount      int
+	MaybeHeadcount int
+	// Katılanların refakatçileri arasında çocuk olarak bildirilenler
+	Children int
+	// Kontenjan dolduğu için bekleme listesine alınanlar ve kişi sayıları
+	Waitlisted          int
+	WaitlistedHeadcount int
+	// Katılım sorularının yanıt dağılımı; yalnızca GetParticipantOverview doldurur
+	Questions []RSVPQuestionSummary
+}
+
+// RSVPSettings, davet sahibinin katılım bildirimleri için belirlediği sınırlardır.
+type RSVPSettings struct {
+	MaxHeadcount  int        // 0 ise kontenjan yok
+	MaxCompanions int        // Katılımcı başına refakatçi; 0 ise yalnızca kişi sayısı sınırlar
+	Deadline      *time.Time // nil ise son tarih yok
+}
+
+// RSVPAvailability, davetiye sayfasında gösterilen katılım bildirimi durumudur.
+type RSVPAvailability struct {
+	Deadline  *time.Time // davetiyenin saat diliminde; nil ise son tarih yok
+	Closed    bool       // son tarih geçtiği için bildirim alınmıyor
+	Capacity  int        // 0 ise kontenjan yok
+	Remaining int        // kontenjanda kalan kişi sayısı
+	Full      bool       // yeni katılım bildirimleri bekleme listesine alınır
+}
+
 type IInvitationService interface {
 	GetAllInvitations(params queryparams.ListParams) (*queryparams.PaginatedResult, error)
 	GetInvitationByID(ctx context.Context, id uint) (*models.Invitation, error)
+	GetPublicInvitationByKey(key string) (*models.Invitation, error)
 	CreateInvitation(ctx context.Context, invitation *models.Invitation) error
 	UpdateInvitation(ctx context.Context, id uint, invitation *models.Invitation) error
 	DeleteInvitation(ctx context.Context, id uint) error
+	// UpdateInvitationTheme, yalnızca davetiyenin temasını değiştirir; tema kategoriyi desteklemelidir.
+	UpdateInvitationTheme(ctx context.Context, id uint, theme string) error
 	GetParticipantsByInvitationID(invitationID uint) ([]models.InvitationParticipant, error)
 	UpdateParticipant(id uint, participant *models.InvitationParticipant) error
 	DeleteParticipant(id uint) error
+	// GetParticipantOverview, katılımcıları verilen yanıta göre süzer; yanıt boşsa tümü döner.
+	// Özet, katılım sorularının yanıtları dahil her zaman tüm listeyi kapsar.
+	GetParticipantOverview(invitationID uint, status models.RSVPStatus) ([]models.InvitationParticipant, *ParticipantSummary, error)
+	// GetParticipantHistory, yanıt geçmişini katılımcıya göre gruplanmış ve en yeni kayıt başta olacak şekilde döndürür.
+	GetParticipantHistory(invitationID uint) (map[uint][]models.ParticipantResponse, error)
+	// SubmitRSVP, katılım bildirimini kaydeder; answers, katılım sorularına verilen yanıtlardır (soru numarasına göre).
+	SubmitRSVP(invitationKey string, participant *models.InvitationParticipant, answers map[uint][]string) (bool, error)
+	// UpdateRSVPSettings, kontenjanı, refakatçi sınırını ve son bildirim tarihini kaydeder;
+	// kontenjan artırıldıysa bekleme listesindekiler sırayla katılımcı yapılır.
+	UpdateRSVPSettings(ctx context.Context, id uint, settings RSVPSettings) error
+	// GetRSVPAvailability, son tarih ve kalan kontenjan bilgisini döndürür.
+	GetRSVPAvailability(invitation *models.Invitation) (*RSVPAvailability, error)
 }
 
 type InvitationService struct {
-	repo repositories.IInvitationRepository
+	repo         repositories.IInvitationRepository
+	questionRepo repositories.IRSVPQuestionRepository
+	slugService  ISlugService
 }
 
 func NewInvitationService() IInvitationService {
-	return &InvitationService{repo: repositories.NewInvitationRepository()}
+	return &InvitationService{
+		repo:         repositories.NewInvitationRepository(),
+		questionRepo: repositories.NewRSVPQuestionRepository(),
+		slugService:  NewSlugService(),
+	}
 }
 
 func (s *InvitationService) GetAllInvitations(params queryparams.ListParams) (*queryparams.PaginatedResult, error) {
@@ -54,7 +151,30 @@ func (s *InvitationService) GetInvitationByID(ctx context.Context, id uint) (*mo
 	return s.repo.GetInvitationByID(id)
 }
 
+func (s *InvitationService) GetPublicInvitationByKey(key string) (*models.Invitation, error) {
+	invitation, err := s.repo.GetConfirmedInvitationByKey(key)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+		logconfig.Log.Error("Davetiye anahtarla alınamadı", zap.String("invitation_key", key), zap.Error(err))
+		return nil, ErrInvitationGeneric
+	}
+	return invitation, nil
+}
+
 func (s *InvitationService) CreateInvitation(ctx context.Context, invitation *models.Invitation) error {
+	invitation.InvitationKey = strings.TrimSpace(invitation.InvitationKey)
+	if err := s.slugService.EnsureAvailable(SlugKindInvitation, invitation.InvitationKey, 0); err != nil {
+		return err
+	}
+	if err := validateInvitationTheme(invitation.Template); err != nil {
+		return err
+	}
+	normalizeInvitationLocales(invitation)
+	if err := normalizeInvitationSchedule(invitation); err != nil {
+		return err
+	}
 	db, ok := ctx.Value("db").(*gorm.DB)
 	if !ok || db == nil {
 		db = databaseconfig.GetDB()
@@ -75,11 +195,28 @@ func (s *InvitationService) CreateInvitation(ctx context.Context, invitation *mo
 				return err
 			}
 		}
+		for i := range invitation.Translations {
+			invitation.Translations[i].InvitationID = invitation.ID
+			if err := tx.Create(&invitation.Translations[i]).Error; err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 }
 
 func (s *InvitationService) UpdateInvitation(ctx context.Context, id uint, invitation *models.Invitation) error {
+	invitation.InvitationKey = strings.TrimSpace(invitation.InvitationKey)
+	if err := s.slugService.EnsureAvailable(SlugKindInvitation, invitation.InvitationKey, id); err != nil {
+		return err
+	}
+	if err := validateInvitationTheme(invitation.Template); err != nil {
+		return err
+	}
+	normalizeInvitationLocales(invitation)
+	if err := normalizeInvitationSchedule(invitation); err != nil {
+		return err
+	}
 	db, ok := ctx.Value("db").(*gorm.DB)
 	if !ok || db == nil {
 		db = databaseconfig.GetDB()
@@ -100,16 +237,31 @@ func (s *InvitationService) UpdateInvitation(ctx context.Context, id uint, invit
 			"link":           invitation.Link,
 			"telephone":      invitation.Telephone,
 			"note":           invitation.Note,
-			"date":           invitation.Date,
-			"time":           invitation.Time,
+			"starts_at":      invitation.StartsAt,
+			"ends_at":        invitation.EndsAt,
+			"all_day":        invitation.AllDay,
+			"time_zone":      invitation.TimeZone,
 			"is_confirmed":   invitation.IsConfirmed,
 			"is_participant": invitation.IsParticipant,
+			"is_public":      invitation.IsPublic,
+		}
+		if invitation.PrimaryLocale != "" {
+			updateData["primary_locale"] = invitation.PrimaryLocale
 		}
 		if err := s.repo.UpdateInvitation(ctx, id, updateData, 0); err != nil {
 			return err
 		}
 		tx.Where("invitation_id = ?", id).Delete(&models.InvitationDetail{})
-		tx.Where("invitation_id = ?", id).Delete(&models.InvitationParticipant{})
+		// Katılımcılar website üzerinden eklendiği için yalnızca açıkça gönderildiğinde değiştirilir
+		if invitation.Participants != nil {
+			tx.Where("invitation_id = ?", id).Delete(&models.InvitationParticipant{})
+		}
+		// Dil başına tekil indeks olduğundan çeviriler kalıcı olarak silinip yeniden yazılır
+		if invitation.Translations != nil {
+			if err := tx.Unscoped().Where("invitation_id = ?", id).Delete(&models.InvitationTranslation{}).Error; err != nil {
+				return err
+			}
+		}
 		if invitation.InvitationDetail != nil {
 			invitation.InvitationDetail.InvitationID = id
 			if err := tx.Create(invitation.InvitationDetail).Error; err != nil {
@@ -122,6 +274,12 @@ func (s *InvitationService) UpdateInvitation(ctx context.Context, id uint, invit
 				return err
 			}
 		}
+		for i := range invitation.Translations {
+			invitation.Translations[i].InvitationID = id
+			if err := tx.Create(&invitation.Translations[i]).Error; err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 }
@@ -131,10 +289,78 @@ func (s *InvitationService) DeleteInvitation(ctx context.Context, id uint) error
 	return db.Transaction(func(tx *gorm.DB) error {
 		tx.Where("invitation_id = ?", id).Delete(&models.InvitationDetail{})
 		tx.Where("invitation_id = ?", id).Delete(&models.InvitationParticipant{})
+		tx.Unscoped().Where("invitation_id = ?", id).Delete(&models.InvitationTranslation{})
 		return s.repo.DeleteInvitation(ctx, id)
 	})
 }
 
+func (s *InvitationService) UpdateInvitationTheme(ctx context.Context, id uint, theme string) error {
+	invitation, err := s.repo.GetInvitationByID(id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrInvitationNotFound
+		}
+		logconfig.Log.Error("Tema için davetiye alınamadı", zap.Uint("invitation_id", id), zap.Error(err))
+		return ErrInvitationGeneric
+	}
+	selected, ok := themes.Get(theme)
+	if !ok {
+		return ErrThemeNotFound
+	}
+	if !selected.Supports(InvitationCategoryTemplate(invitation)) {
+		return ErrThemeNotSupported
+	}
+	if err := s.repo.UpdateInvitation(ctx, id, map[string]interface{}{"template": selected.Name}, 0); err != nil {
+		logconfig.Log.Error("Davetiye teması güncellenemedi", zap.Uint("invitation_id", id), zap.Error(err))
+		return ErrInvitationGeneric
+	}
+	return nil
+}
+
+func (s *InvitationService) UpdateRSVPSettings(ctx context.Context, id uint, settings RSVPSettings) error {
+	if settings.MaxHeadcount < 0 || settings.MaxHeadcount > MaxRSVPHeadcount {
+		return ErrRSVPMaxHeadcount
+	}
+	if settings.MaxCompanions < 0 || settings.MaxCompanions > MaxRSVPCompanions {
+		return ErrRSVPMaxCompanions
+	}
+	data := map[string]interface{}{
+		"max_headcount":  settings.MaxHeadcount,
+		"max_companions": settings.MaxCompanions,
+		"rsvp_deadline":  settings.Deadline,
+	}
+	if err := s.repo.UpdateInvitation(ctx, id, data, 0); err != nil {
+		logconfig.Log.Error("Katılım ayarları güncellenemedi", zap.Uint("invitation_id", id), zap.Error(err))
+		return ErrInvitationGeneric
+	}
+	if err := s.repo.PromoteWaitlisted(ctx, id); err != nil {
+		logconfig.Log.Error("Bekleme listesi güncellenemedi", zap.Uint("invitation_id", id), zap.Error(err))
+		return ErrInvitationGeneric
+	}
+	return nil
+}
+
+func (s *InvitationService) GetRSVPAvailability(invitation *models.Invitation) (*RSVPAvailability, error) {
+	availability := &RSVPAvailability{
+		Closed:   RSVPDeadlinePassed(invitation, time.Now()),
+		Capacity: invitation.MaxHeadcount,
+	}
+	if invitation.RSVPDeadline != nil {
+		deadline := invitation.RSVPDeadline.In(InvitationLocation(invitation))
+		availability.Deadline = &deadline
+	}
+	if invitation.MaxHeadcount > 0 {
+		taken, err := s.repo.GetAttendingHeadcount(invitation.ID)
+		if err != nil {
+			logconfig.Log.Error("Kontenjan hesaplanamadı", zap.Uint("invitation_id", invitation.ID), zap.Error(err))
+			return nil, ErrInvitationGeneric
+		}
+		availability.Remaining = max(invitation.MaxHeadcount-taken, 0)
+		availability.Full = availability.Remaining == 0
+	}
+	return availability, nil
+}
+
 func (s *InvitationService) GetParticipantsByInvitationID(invitationID uint) ([]models.InvitationParticipant, error) {
 	return s.repo.GetParticipantsByInvitationID(invitationID)
 }
@@ -146,3 +372,316 @@ func (s *InvitationService) UpdateParticipant(id uint, participant *models.Invit
 func (s *InvitationService) DeleteParticipant(id uint) error {
 	return s.repo.DeleteParticipant(id)
 }
+
+func (s *InvitationService) GetParticipantOverview(invitationID uint, status models.RSVPStatus) ([]models.InvitationParticipant, *ParticipantSummary, error) {
+	participants, err := s.repo.GetParticipantsByInvitationID(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Katılımcılar alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, nil, ErrInvitationGeneric
+	}
+	questions, err := s.questionRepo.GetQuestionsByInvitationID(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Katılım soruları alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, nil, ErrInvitationGeneric
+	}
+	summary := SummarizeParticipants(participants)
+	summary.Questions = SummarizeRSVPAnswers(questions, participants)
+	filtered := participants[:0:0]
+	for _, participant := range participants {
+		if status == "" || participant.Status == status {
+			filtered = append(filtered, participant)
+		}
+	}
+	return filtered, &summary, nil
+}
+
+func (s *InvitationService) GetParticipantHistory(invitationID uint) (map[uint][]models.ParticipantResponse, error) {
+	responses, err := s.repo.GetParticipantResponses(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Yanıt geçmişi alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, ErrInvitationGeneric
+	}
+	history := make(map[uint][]models.ParticipantResponse)
+	for _, response := range responses {
+		history[response.ParticipantID] = append(history[response.ParticipantID], response)
+	}
+	return history, nil
+}
+
+// SummarizeParticipants, yanıtları sayar; kesin kişi sayısı yalnızca katılanlardan, olası kişi sayısı "belki" diyenlerden hesaplanır.
+func SummarizeParticipants(participants []models.InvitationParticipant) ParticipantSummary {
+	summary := ParticipantSummary{Total: len(participants)}
+	for _, participant := range participants {
+		switch participant.Status {
+		case models.RSVPDeclined:
+			summary.Declined++
+		case models.RSVPMaybe:
+			summary.Maybe++
+			summary.MaybeHeadcount += participant.GuestCount
+		case models.RSVPWaitlisted:
+			summary.Waitlisted++
+			summary.WaitlistedHeadcount += participant.GuestCount
+		default:
+			summary.Attending++
+			summary.Headcount += participant.GuestCount
+			for _, companion := range participant.Companions {
+				if companion.IsChild() {
+					summary.Children++
+				}
+			}
+		}
+	}
+	return summary
+}
+
+// RSVPDeadlinePassed, davetiyenin son bildirim tarihi verilen andan önceyse true döner.
+func RSVPDeadlinePassed(invitation *models.Invitation, now time.Time) bool {
+	return invitation.RSVPDeadline != nil && now.After(*invitation.RSVPDeadline)
+}
+
+// ParseRSVPStatus, sorgu parametresindeki yanıtı doğrular; geçersizse boş döner.
+func ParseRSVPStatus(value string) models.RSVPStatus {
+	status := models.RSVPStatus(value)
+	if _, ok := rsvpStatusLabels[status]; ok {
+		return status
+	}
+	return ""
+}
+
+// Katılım bildirimini kaydeder; aynı telefonla yapılan tekrar bildirimler mevcut kaydı günceller.
+func (s *InvitationService) SubmitRSVP(invitationKey string, participant *models.InvitationParticipant, answers map[uint][]string) (bool, error) {
+	invitation, err := s.GetPublicInvitationByKey(invitationKey)
+	if err != nil {
+		return false, err
+	}
+	if !invitation.IsParticipant {
+		return false, ErrParticipationClosed
+	}
+	if RSVPDeadlinePassed(invitation, time.Now()) {
+		return false, ErrRSVPDeadlinePassed
+	}
+
+	phone, err := phonenumber.Normalize(participant.PhoneNumber)
+	if err != nil {
+		return false, ErrInvalidPhoneNumber
+	}
+	if err := normalizeRSVPResponse(participant); err != nil {
+		return false, err
+	}
+	if err := normalizeCompanions(participant, invitation.MaxCompanions); err != nil {
+		return false, err
+	}
+	questions, err := s.questionRepo.GetQuestionsByInvitationID(invitation.ID)
+	if err != nil {
+		logconfig.Log.Error("Katılım soruları alınamadı", zap.Uint("invitation_id", invitation.ID), zap.Error(err))
+		return false, ErrRSVPGeneric
+	}
+	participant.Answers, err = BuildRSVPAnswers(questions, answers, participant.Status)
+	if err != nil {
+		return false, err
+	}
+	participant.PhoneNumber = phone
+	participant.InvitationID = invitation.ID
+	participant.RespondedAt = time.Now()
+
+	created, err := s.repo.SaveParticipantByPhone(participant)
+	if errors.Is(err, repositories.ErrCapacityExceeded) {
+		return false, ErrRSVPCapacity
+	}
+	if err != nil {
+		logconfig.Log.Error("Katılım bildirimi kaydedilemedi",
+			zap.Uint("invitation_id", invitation.ID),
+			zap.Error(err))
+		return false, ErrRSVPGeneric
+	}
+	return created, nil
+}
+
+// normalizeRSVPResponse, yanıt boşsa katılıyor kabul eder; katılmayanların kişi sayısını sıfırlar,
+// katılan ya da kararsız olanlarda en az bir kişi ister.
+func normalizeRSVPResponse(participant *models.InvitationParticipant) error {
+	if participant.Status == "" {
+		participant.Status = models.RSVPAttending
+	}
+	if _, ok := rsvpStatusLabels[participant.Status]; !ok || participant.Status == models.RSVPWaitlisted {
+		return ErrInvalidRSVPStatus
+	}
+	if participant.Status == models.RSVPDeclined {
+		participant.GuestCount = 0
+		return nil
+	}
+	if participant.GuestCount < 1 {
+		return ErrRSVPGuestCount
+	}
+	return nil
+}
+
+// normalizeCompanions, refakatçi alanlarını kırpar ve tamamen boş satırları atar. Kişi sayısı katılımcının kendisi
+// dahil olduğundan en fazla GuestCount-1 refakatçi kabul edilir; davet sahibi sınır koyduysa kişi sayısı da buna uymalıdır.
+// Katılmayanların refakatçisi tutulmaz.
+func normalizeCompanions(participant *models.InvitationParticipant, limit int) error {
+	if participant.Status == models.RSVPDeclined {
+		participant.Companions = nil
+		return nil
+	}
+	if limit > 0 && participant.GuestCount > limit+1 {
+		return ErrRSVPCompanionLimit
+	}
+	companions := participant.Companions[:0:0]
+	for _, companion := range participant.Companions {
+		companion.Name = strings.TrimSpace(companion.Name)
+		companion.DietaryNote = strings.TrimSpace(companion.DietaryNote)
+		if companion.Name == "" && companion.DietaryNote == "" {
+			continue
+		}
+		if n := utf8.RuneCountInString(companion.Name); n < 2 || n > 100 {
+			return ErrCompanionName
+		}
+		if utf8.RuneCountInString(companion.DietaryNote) > 255 {
+			return ErrCompanionDiet
+		}
+		if companion.AgeGroup != models.CompanionChild {
+			companion.AgeGroup = models.CompanionAdult
+		}
+		companions = append(companions, companion)
+	}
+	if len(companions) > participant.GuestCount-1 {
+		return ErrCompanionCount
+	}
+	participant.Companions = companions
+	return nil
+}
+
+// normalizeInvitationLocales, geçersiz birincil dili boşaltır (kayıtta varsayılan dil kalır) ve çevirilerden desteklenmeyen,
+// birincil dille aynı, tekrarlanan ya da tamamen boş olanları ayıklar.
+func normalizeInvitationLocales(invitation *models.Invitation) {
+	invitation.PrimaryLocale = i18n.Normalize(invitation.PrimaryLocale)
+	if invitation.Translations == nil {
+		return
+	}
+	primary := invitationPrimaryLocale(invitation)
+	translations := make([]models.InvitationTranslation, 0, len(invitation.Translations))
+	seen := make(map[string]bool, len(invitation.Translations))
+	for _, translation := range invitation.Translations {
+		locale := i18n.Normalize(translation.Locale)
+		if locale != translation.Locale || locale == primary || seen[locale] || translation.IsEmpty() {
+			continue
+		}
+		seen[locale] = true
+		translations = append(translations, translation)
+	}
+	invitation.Translations = translations
+}
+
+// normalizeInvitationSchedule, saat dilimini doğrular (boşsa uygulamanın saat dilimi kullanılır)
+// ve başlangıcı olmayan davetiyelerde bitişi temizler.
+func normalizeInvitationSchedule(invitation *models.Invitation) error {
+	invitation.TimeZone = strings.TrimSpace(invitation.TimeZone)
+	if invitation.TimeZone == "" {
+		invitation.TimeZone = envconfig.GetTimeZone()
+	}
+	if _, err := eventtime.LoadLocation(invitation.TimeZone); err != nil {
+		return ErrInvalidTimeZone
+	}
+	if invitation.StartsAt.IsZero() {
+		invitation.EndsAt = nil
+		invitation.AllDay = false
+		return nil
+	}
+	if invitation.EndsAt != nil && !endsAfterStart(invitation.StartsAt, *invitation.EndsAt, invitation.AllDay) {
+		return ErrInvitationEndTime
+	}
+	return nil
+}
+
+// endsAfterStart, bitişin başlangıçtan sonra olduğunu denetler; tüm gün etkinlikleri aynı gün bitebilir.
+func endsAfterStart(start, end time.Time, allDay bool) bool {
+	if allDay {
+		return !end.Before(start)
+	}
+	return end.After(start)
+}
+
+// InvitationLocation, davetiyenin saat dilimini döndürür; kayıtlı ad geçersizse uygulamanın saat dilimi kullanılır.
+func InvitationLocation(invitation *models.Invitation) *time.Location {
+	if loc, err := eventtime.LoadLocation(invitation.TimeZone); err == nil {
+		return loc
+	}
+	return envconfig.GetLocation()
+}
+
+// InvitationContentLocales, davetiye içeriğinin sunulduğu dilleri birincil dil başta olacak şekilde döndürür.
+func InvitationContentLocales(invitation *models.Invitation) []string {
+	locales := []string{invitationPrimaryLocale(invitation)}
+	for _, translation := range invitation.Translations {
+		if translation.Locale != locales[0] {
+			locales = append(locales, translation.Locale)
+		}
+	}
+	return locales
+}
+
+// InvitationTranslationsByLocale, formlarda kullanılmak üzere çevirileri dil koduna göre eşler.
+func InvitationTranslationsByLocale(invitation *models.Invitation) map[string]models.InvitationTranslation {
+	translations := make(map[string]models.InvitationTranslation)
+	if invitation == nil {
+		return translations
+	}
+	for _, translation := range invitation.Translations {
+		translations[translation.Locale] = translation
+	}
+	return translations
+}
+
+// LocalizeInvitation, istenen dildeki çeviriyi davetiye ve detayının kopyalarına uygular.
+// Çevirisi olmayan alanlarda birincil dildeki içerik kalır; kaynak kayıt değiştirilmez.
+func LocalizeInvitation(invitation *models.Invitation, locale string) (*models.Invitation, *models.InvitationDetail) {
+	localized := *invitation
+	var detail *models.InvitationDetail
+	if invitation.InvitationDetail != nil {
+		detailCopy := *invitation.InvitationDetail
+		detail = &detailCopy
+		localized.InvitationDetail = detail
+	}
+	if locale == invitationPrimaryLocale(invitation) {
+		return &localized, detail
+	}
+
+	translation, ok := InvitationTranslationsByLocale(invitation)[locale]
+	if !ok {
+		return &localized, detail
+	}
+	localized.Title = orDefault(translation.Title, localized.Title)
+	localized.Description = orDefault(translation.Description, localized.Description)
+	localized.Note = orDefault(translation.Note, localized.Note)
+	localized.Venue = orDefault(translation.Venue, localized.Venue)
+	if detail != nil {
+		detail.Title = orDefault(translation.DetailTitle, detail.Title)
+	}
+	return &localized, detail
+}
+
+// validateInvitationTheme, boş bırakılan temaya izin verir; bu durumda varsayılan tema kullanılır.
+func validateInvitationTheme(name string) error {
+	if name == "" {
+		return nil
+	}
+	if _, ok := themes.Get(name); !ok {
+		return ErrThemeNotFound
+	}
+	return nil
+}
+
+func invitationPrimaryLocale(invitation *models.Invitation) string {
+	if locale := i18n.Normalize(invitation.PrimaryLocale); locale != "" {
+		return locale
+	}
+	return i18n.DefaultLocale
+}
+
+func orDefault(value, fallback string) string {
+	if strings.TrimSpace(value) == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"image"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"davet.link/configs/fileconfig"
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/imageutil"
+	"davet.link/pkg/ogimage"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrOGImageGeneric ServiceError = "önizleme görseli oluşturulurken bir hata oluştu"
+)
+
+const (
+	OGImageContentType = "og"
+	// Görsel düzeni değiştiğinde eski önbelleğin geçersiz olması için artırılır
+	ogImageLayoutVersion = "v1"
+	uploadsURLPrefix     = "/uploads/"
+	publicDir            = "public"
+	// Paylaşım önizlemelerinde gösterilen açıklamanın en fazla karakter sayısı
+	metaDescriptionMaxLength = 200
+)
+
+type IOGImageService interface {
+	// Önbellekteki (gerekirse yeni üretilen) PNG dosyasının yolunu döndürür.
+	GetInvitationImagePath(invitationKey string) (string, error)
+	GetCardImagePath(cardSlug string) (string, error)
+}
+
+type OGImageService struct {
+	invitationRepo repositories.IInvitationRepository
+	cardRepo       repositories.ICardRepository
+}
+
+func NewOGImageService() IOGImageService {
+	return &OGImageService{
+		invitationRepo: repositories.NewInvitationRepository(),
+		cardRepo:       repositories.NewCardRepository(),
+	}
+}
+
+func (s *OGImageService) GetInvitationImagePath(invitationKey string) (string, error) {
+	invitation, err := s.invitationRepo.GetConfirmedInvitationByKey(invitationKey)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return "", ErrInvitationNotFound
+		}
+		logconfig.Log.Error("Önizleme için davetiye alınamadı", zap.String("invitation_key", invitationKey), zap.Error(err))
+		return "", ErrOGImageGeneric
+	}
+
+	return s.cached(fmt.Sprintf("invitation-%d", invitation.ID), InvitationModifiedAt(invitation), func() ogimage.Preview {
+		preview := ogimage.Preview{
+			Background: loadLocalImage(invitation.Image),
+			Title:      invitationSummary(invitation),
+			Subtitle:   invitationHosts(invitation.InvitationDetail),
+		}
+		if !invitation.StartsAt.IsZero() {
+			start := invitation.StartsAt.In(InvitationLocation(invitation))
+			when := start.Format("02.01.2006")
+			if !invitation.AllDay {
+				when += " · " + start.Format("15:04")
+			}
+			preview.Lines = append(preview.Lines, when)
+		}
+		if venue := joinNonEmpty(", ", invitation.Venue, invitation.Address); venue != "" {
+			preview.Lines = append(preview.Lines, venue)
+		}
+		return preview
+	})
+}
+
+func (s *OGImageService) GetCardImagePath(cardSlug string) (string, error) {
+	card, err := s.cardRepo.GetActiveCardBySlug(cardSlug)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return "", ErrCardNotFound
+		}
+		logconfig.Log.Error("Önizleme için kartvizit alınamadı", zap.String("slug", cardSlug), zap.Error(err))
+		return "", ErrOGImageGeneric
+	}
+
+	return s.cached(fmt.Sprintf("card-%d", card.ID), card.UpdatedAt, func() ogimage.Preview {
+		title := card.Name
+		if title == "" {
+			title = "@" + card.Slug
+		}
+		preview := ogimage.Preview{
+			Avatar:   loadLocalImage(card.Photo),
+			Title:    title,
+			Subtitle: card.Title,
+		}
+		if card.Location != "" {
+			preview.Lines = append(preview.Lines, card.Location)
+		}
+		return preview
+	})
+}
+
+// cached, kayıt güncellendikçe değişen dosya adıyla önbelleğe yazar; aynı kaydın eski dosyaları silinir.
+func (s *OGImageService) cached(prefix string, modifiedAt time.Time, build func() ogimage.Preview) (string, error) {
+	dir := fileconfig.Config.GetPath(OGImageContentType)
+	name := fmt.Sprintf("%s-%s-%d.png", prefix, ogImageLayoutVersion, modifiedAt.UnixNano())
+	path := filepath.Join(dir, name)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	data, err := ogimage.Render(build())
+	if err != nil {
+		logconfig.Log.Error("Önizleme görseli oluşturulamadı", zap.String("file", name), zap.Error(err))
+		return "", ErrOGImageGeneric
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		logconfig.Log.Error("Önizleme klasörü oluşturulamadı", zap.String("dir", dir), zap.Error(err))
+		return "", ErrOGImageGeneric
+	}
+
+	// Yarım yazılmış dosya sunulmasın diye önce geçici dosyaya yazılır
+	tmp, err := os.CreateTemp(dir, name+".*.tmp")
+	if err != nil {
+		logconfig.Log.Error("Önizleme görseli yazılamadı", zap.String("file", name), zap.Error(err))
+		return "", ErrOGImageGeneric
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(tmp.Name())
+		logconfig.Log.Error("Önizleme görseli yazılamadı", zap.String("file", name), zap.Error(errors.Join(writeErr, closeErr)))
+		return "", ErrOGImageGeneric
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+		logconfig.Log.Error("Önizleme görseli kaydedilemedi", zap.String("file", name), zap.Error(err))
+		return "", ErrOGImageGeneric
+	}
+
+	stale, _ := filepath.Glob(filepath.Join(dir, prefix+"-*.png"))
+	for _, old := range stale {
+		if old != path {
+			_ = os.Remove(old)
+		}
+	}
+	return path, nil
+}
+
+// InvitationModifiedAt, davetiye ve detayının en son güncellenme zamanını döndürür.
+func InvitationModifiedAt(invitation *models.Invitation) time.Time {
+	modifiedAt := invitation.UpdatedAt
+	if invitation.InvitationDetail != nil && invitation.InvitationDetail.UpdatedAt.After(modifiedAt) {
+		modifiedAt = invitation.InvitationDetail.UpdatedAt
+	}
+	return modifiedAt
+}
+
+// InvitationMetaDescription, paylaşım önizlemesi için düz metin açıklama üretir.
+func InvitationMetaDescription(invitation *models.Invitation) string {
+	description := strings.Join(strings.Fields(html.UnescapeString(htmlTagPattern.ReplaceAllString(invitation.Description, " "))), " ")
+	if description == "" {
+		var when string
+		if !invitation.StartsAt.IsZero() {
+			when = invitation.StartsAt.In(InvitationLocation(invitation)).Format("02.01.2006")
+		}
+		description = joinNonEmpty(" · ", invitationHosts(invitation.InvitationDetail), when, invitation.Venue)
+	}
+	if runes := []rune(description); len(runes) > metaDescriptionMaxLength {
+		description = strings.TrimSpace(string(runes[:metaDescriptionMaxLength-1])) + "…"
+	}
+	return description
+}
+
+func invitationHosts(detail *models.InvitationDetail) string {
+	if detail == nil {
+		return ""
+	}
+	if detail.BrideName != "" || detail.GroomName != "" {
+		return joinNonEmpty(" & ",
+			joinNonEmpty(" ", detail.BrideName, detail.BrideSurname),
+			joinNonEmpty(" ", detail.GroomName, detail.GroomSurname))
+	}
+	return detail.Person
+}
+
+// loadLocalImage, yalnızca sunucudaki dosyaları okur; uzak adresler için istek atılmaz.
+func loadLocalImage(ref string) image.Image {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || !strings.HasPrefix(ref, "/") || strings.HasPrefix(ref, "//") {
+		return nil
+	}
+	ref = strings.SplitN(ref, "?", 2)[0]
+
+	var path string
+	if strings.HasPrefix(ref, uploadsURLPrefix) {
+		path = filepath.Join(fileconfig.Config.BasePath, filepath.FromSlash(filepath.Clean("/"+strings.TrimPrefix(ref, uploadsURLPrefix))))
+	} else {
+		path = filepath.Join(publicDir, filepath.FromSlash(filepath.Clean(ref)))
+	}
+
+	img, err := imageutil.DecodeFile(path)
+	if err != nil {
+		logconfig.Log.Warn("Önizleme için görsel okunamadı", zap.String("path", path), zap.Error(err))
+		return nil
+	}
+	return img
+}
+
+var _ IOGImageService = (*OGImageService)(nil)
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/queryparams"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrPageNotFound ServiceError = "sayfa bulunamadı"
+	ErrPageGeneric  ServiceError = "sayfa işlenirken bir hata oluştu"
+)
+
+type IPageService interface {
+	GetAllPages(params queryparams.ListParams) (*queryparams.PaginatedResult, error)
+	GetPageByID(id uint) (*models.Page, error)
+	GetPublishedPageBySlug(slug string) (*models.Page, error)
+	GetPublishedPages() ([]models.Page, error)
+	CreatePage(ctx context.Context, page *models.Page) error
+	UpdatePage(ctx context.Context, id uint, pageData *models.Page, updatedBy uint) error
+	DeletePage(ctx context.Context, id uint) error
+}
+
+type PageService struct {
+	repo        repositories.IPageRepository
+	slugService ISlugService
+}
+
+func NewPageService() IPageService {
+	return &PageService{
+		repo:        repositories.NewPageRepository(),
+		slugService: NewSlugService(),
+	}
+}
+
+func (s *PageService) GetAllPages(params queryparams.ListParams) (*queryparams.PaginatedResult, error) {
+	pages, totalCount, err := s.repo.GetAllPages(params)
+	if err != nil {
+		logconfig.Log.Error("Sayfalar alınamadı", zap.Error(err))
+		return nil, errors.New("sayfalar getirilirken bir hata oluştu")
+	}
+	return &queryparams.PaginatedResult{
+		Data: pages,
+		Meta: queryparams.PaginationMeta{
+			CurrentPage: params.Page,
+			PerPage:     params.PerPage,
+			TotalItems:  totalCount,
+			TotalPages:  queryparams.CalculateTotalPages(totalCount, params.PerPage),
+		},
+	}, nil
+}
+
+func (s *PageService) GetPageByID(id uint) (*models.Page, error) {
+	page, err := s.repo.GetPageByID(id)
+	if err != nil {
+		logconfig.Log.Warn("Sayfa bulunamadı", zap.Uint("page_id", id), zap.Error(err))
+		return nil, ErrPageNotFound
+	}
+	return page, nil
+}
+
+func (s *PageService) GetPublishedPageBySlug(slug string) (*models.Page, error) {
+	page, err := s.repo.GetPublishedPageBySlug(slug)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrPageNotFound
+		}
+		logconfig.Log.Error("Sayfa alınamadı", zap.String("slug", slug), zap.Error(err))
+		return nil, ErrPageGeneric
+	}
+	return page, nil
+}
+
+func (s *PageService) GetPublishedPages() ([]models.Page, error) {
+	return s.repo.GetPublishedPages()
+}
+
+func (s *PageService) CreatePage(ctx context.Context, page *models.Page) error {
+	page.Slug = strings.ToLower(strings.TrimSpace(page.Slug))
+	if err := s.slugService.EnsureAvailable(SlugKindPage, page.Slug, 0); err != nil {
+		return err
+	}
+	return s.repo.CreatePage(ctx, page)
+}
+
+func (s *PageService) UpdatePage(ctx context.Context, id uint, pageData *models.Page, updatedBy uint) error {
+	if _, err := s.repo.GetPageByID(id); err != nil {
+		return ErrPageNotFound
+	}
+	slug := strings.ToLower(strings.TrimSpace(pageData.Slug))
+	if err := s.slugService.EnsureAvailable(SlugKindPage, slug, id); err != nil {
+		return err
+	}
+	updateData := map[string]interface{}{
+		"slug":             slug,
+		"title":            pageData.Title,
+		"body":             pageData.Body,
+		"meta_title":       pageData.MetaTitle,
+		"meta_description": pageData.MetaDescription,
+		"is_published":     pageData.IsPublished,
+	}
+	return s.repo.UpdatePage(ctx, id, updateData, updatedBy)
+}
+
+func (s *PageService) DeletePage(ctx context.Context, id uint) error {
+	return s.repo.DeletePage(ctx, id)
+}
+
+var _ IPageService = (*PageService)(nil)
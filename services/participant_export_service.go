@@ -0,0 +1,234 @@
+package services
+
+import (
+	"encoding/csv"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"davet.link/configs/envconfig"
+	"davet.link/models"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/pdf"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+type IParticipantExportService interface {
+	// WriteCSV, katılımcıları Excel'in Türkçe karakterleri doğru açabileceği biçimde (UTF-8 BOM) satır satır yazar.
+	// Refakatçiler tek sütunda, her katılım sorusu ayrı bir sütun olarak eklenir.
+	WriteCSV(w io.Writer, participants []models.InvitationParticipant, questions []models.RSVPQuestion, locale string) error
+	// WritePDF, katılımcıları kişi sayısı toplamıyla birlikte yazdırılabilir A4 liste olarak yazar;
+	// refakatçiler ve katılım sorularının yanıtları listenin ardından eklenir.
+	WritePDF(w io.Writer, invitation *models.Invitation, participants []models.InvitationParticipant, questions []models.RSVPQuestion, locale string) error
+}
+
+type ParticipantExportService struct{}
+
+func NewParticipantExportService() IParticipantExportService {
+	return &ParticipantExportService{}
+}
+
+func (s *ParticipantExportService) WriteCSV(w io.Writer, participants []models.InvitationParticipant, questions []models.RSVPQuestion, locale string) error {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	header := []string{i18n.T(locale, "Ad Soyad"), i18n.T(locale, "Telefon"), i18n.T(locale, "Yanıt"), i18n.T(locale, "Kişi Sayısı"), i18n.T(locale, "Yanıt Tarihi"), i18n.T(locale, "Refakatçiler")}
+	for _, question := range questions {
+		header = append(header, csvSafe(question.Label))
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	loc := envconfig.GetLocation()
+	for _, participant := range sortParticipants(participants) {
+		record := []string{
+			csvSafe(participant.Title),
+			csvPhone(participant.PhoneNumber),
+			i18n.T(locale, rsvpStatusLabels[participant.Status]),
+			strconv.Itoa(participant.GuestCount),
+			participant.RespondedAt.In(loc).Format("02.01.2006 15:04"),
+			csvSafe(companionsText(participant.Companions, locale)),
+		}
+		for _, question := range questions {
+			value := ""
+			if answer := participant.Answer(question.ID); answer != nil {
+				value = csvSafe(answer.Text())
+			}
+			record = append(record, value)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (s *ParticipantExportService) WritePDF(w io.Writer, invitation *models.Invitation, participants []models.InvitationParticipant, questions []models.RSVPQuestion, locale string) error {
+	title := invitation.Title
+	if title == "" {
+		title = invitation.InvitationKey
+	}
+	doc := pdf.New(title + " - " + i18n.T(locale, "Katılımcı Listesi"))
+	doc.Footer = "davet.link/" + invitation.InvitationKey + " · " + time.Now().In(envconfig.GetLocation()).Format("02.01.2006 15:04")
+
+	doc.Heading(title)
+	subtitle := i18n.T(locale, "Katılımcı Listesi")
+	if !invitation.StartsAt.IsZero() {
+		layout := "02.01.2006 15:04"
+		if invitation.AllDay {
+			layout = "02.01.2006"
+		}
+		subtitle += " · " + invitation.StartsAt.In(InvitationLocation(invitation)).Format(layout)
+	}
+	if invitation.Venue != "" {
+		subtitle += " · " + invitation.Venue
+	}
+	doc.Text(subtitle)
+	doc.Space(10)
+
+	sorted := sortParticipants(participants)
+	rows := make([][]string, 0, len(participants))
+	for i, participant := range sorted {
+		rows = append(rows, []string{
+			strconv.Itoa(i + 1),
+			participant.Title,
+			participant.PhoneNumber,
+			i18n.T(locale, rsvpStatusLabels[participant.Status]),
+			strconv.Itoa(participant.GuestCount),
+		})
+	}
+	columns := []pdf.Column{
+		{Title: "#", Width: 1, Align: pdf.AlignRight},
+		{Title: i18n.T(locale, "Ad Soyad"), Width: 7},
+		{Title: i18n.T(locale, "Telefon"), Width: 4},
+		{Title: i18n.T(locale, "Yanıt"), Width: 2.5},
+		{Title: i18n.T(locale, "Kişi Sayısı"), Width: 2.5, Align: pdf.AlignRight},
+	}
+	summary := SummarizeParticipants(participants)
+	footer := []string{"", i18n.T(locale, "Katılacak kişi sayısı"), "", "", strconv.Itoa(summary.Headcount)}
+	doc.Table(columns, rows, footer)
+	doc.Text(i18n.T(locale, "%d katılıyor (%d kişi), %d belki (%d kişi), %d katılmıyor",
+		summary.Attending, summary.Headcount, summary.Maybe, summary.MaybeHeadcount, summary.Declined))
+	if summary.Waitlisted > 0 {
+		doc.Text(i18n.T(locale, "%d bekleme listesinde (%d kişi)", summary.Waitlisted, summary.WaitlistedHeadcount))
+	}
+	writeCompanions(doc, sorted, locale)
+	writeQuestionAnswers(doc, questions, sorted, locale)
+
+	_, err := doc.WriteTo(w)
+	return err
+}
+
+// writeCompanions, katılımcıların adını bildirdiği refakatçileri yaş grubu ve beslenme notuyla tablo olarak yazar.
+func writeCompanions(doc *pdf.Document, participants []models.InvitationParticipant, locale string) {
+	var rows [][]string
+	for _, participant := range participants {
+		if participant.Status == models.RSVPDeclined {
+			continue
+		}
+		for _, companion := range participant.Companions {
+			rows = append(rows, []string{participant.Title, companion.Name, companionAgeLabel(companion, locale), companion.DietaryNote})
+		}
+	}
+	if len(rows) == 0 {
+		return
+	}
+	doc.Space(10)
+	doc.Heading(i18n.T(locale, "Refakatçiler"))
+	doc.Table([]pdf.Column{
+		{Title: i18n.T(locale, "Katılımcı"), Width: 5},
+		{Title: i18n.T(locale, "Refakatçi"), Width: 5},
+		{Title: i18n.T(locale, "Yaş Grubu"), Width: 2},
+		{Title: i18n.T(locale, "Beslenme Notu"), Width: 5},
+	}, rows, nil)
+}
+
+// companionsText, refakatçileri tek hücrede "Ad (Çocuk, not); Ad" biçiminde birleştirir.
+func companionsText(companions []models.ParticipantCompanion, locale string) string {
+	parts := make([]string, 0, len(companions))
+	for _, companion := range companions {
+		var details []string
+		if companion.IsChild() {
+			details = append(details, companionAgeLabel(companion, locale))
+		}
+		if companion.DietaryNote != "" {
+			details = append(details, companion.DietaryNote)
+		}
+		part := companion.Name
+		if len(details) > 0 {
+			part += " (" + strings.Join(details, ", ") + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func companionAgeLabel(companion models.ParticipantCompanion, locale string) string {
+	if companion.IsChild() {
+		return i18n.T(locale, "Çocuk")
+	}
+	return i18n.T(locale, "Yetişkin")
+}
+
+// writeQuestionAnswers, her katılım sorusu için seçenekli sorularda seçeneklerin dağılımını,
+// diğer sorularda katılımcıların yanıtlarını yazar. Katılmayanlara soru sorulmadığından onlar dahil edilmez.
+func writeQuestionAnswers(doc *pdf.Document, questions []models.RSVPQuestion, participants []models.InvitationParticipant, locale string) {
+	if len(questions) == 0 {
+		return
+	}
+	doc.Space(10)
+	doc.Heading(i18n.T(locale, "Soru Yanıtları"))
+	for _, summary := range SummarizeRSVPAnswers(questions, participants) {
+		doc.Space(6)
+		doc.Paragraph(summary.Question.Label, pdf.Bold, 11)
+		if summary.Question.IsChoice() {
+			rows := make([][]string, 0, len(summary.Choices))
+			for _, choice := range summary.Choices {
+				rows = append(rows, []string{choice.Choice, strconv.Itoa(choice.Count)})
+			}
+			doc.Table([]pdf.Column{
+				{Title: i18n.T(locale, "Seçenek"), Width: 5},
+				{Title: i18n.T(locale, "Kişi"), Width: 1, Align: pdf.AlignRight},
+			}, rows, nil)
+			continue
+		}
+		if summary.Answered == 0 {
+			doc.Text(i18n.T(locale, "Yanıt yok"))
+			continue
+		}
+		for _, participant := range participants {
+			if participant.Status == models.RSVPDeclined {
+				continue
+			}
+			if answer := participant.Answer(summary.Question.ID); answer != nil {
+				doc.Text(participant.Title + ": " + strings.Join(strings.Fields(answer.Value), " "))
+			}
+		}
+	}
+}
+
+// csvPhone, + ile başlayıp yalnızca rakam içeren numaraları olduğu gibi bırakır; diğer değerler csvSafe'ten geçer.
+func csvPhone(value string) string {
+	if strings.HasPrefix(value, "+") && strings.Trim(value[1:], "0123456789 ") == "" {
+		return value
+	}
+	return csvSafe(value)
+}
+
+// sortParticipants, listeyi Türkçe alfabe sırasına göre (Ç, Ğ, İ, Ö, Ş, Ü yerinde) sıralanmış bir kopya olarak döndürür.
+func sortParticipants(participants []models.InvitationParticipant) []models.InvitationParticipant {
+	sorted := slices.Clone(participants)
+	collator := collate.New(language.Turkish, collate.IgnoreCase)
+	slices.SortStableFunc(sorted, func(a, b models.InvitationParticipant) int {
+		return collator.CompareString(a.Title, b.Title)
+	})
+	return sorted
+}
+
+var _ IParticipantExportService = (*ParticipantExportService)(nil)
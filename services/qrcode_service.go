@@ -0,0 +1,107 @@
+package services
+
+import (
+	"image"
+	_ "image/png"
+	"os"
+	"sync"
+
+	"davet.link/configs/logconfig"
+	"davet.link/pkg/qrcode"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrQRCodeGeneric ServiceError = "QR kod oluşturulurken bir hata oluştu"
+)
+
+const (
+	QRCodeFormatPNG = "png"
+	QRCodeFormatSVG = "svg"
+
+	QRCodeDefaultSize = 512
+	QRCodeMinSize     = 128
+	QRCodeMaxSize     = 2048
+
+	qrCodeLogoPath = "public/davet.link.png"
+)
+
+type QRCodeOptions struct {
+	Format   string
+	Size     int
+	Level    qrcode.Level
+	WithLogo bool
+}
+
+type IQRCodeService interface {
+	// Generate, içeriği kodlar ve (veri, içerik tipi) döndürür.
+	Generate(content string, opts QRCodeOptions) ([]byte, string, error)
+}
+
+type QRCodeService struct {
+	logoOnce sync.Once
+	logo     image.Image
+}
+
+var qrCodeService = &QRCodeService{}
+
+func NewQRCodeService() IQRCodeService {
+	return qrCodeService
+}
+
+func (s *QRCodeService) Generate(content string, opts QRCodeOptions) ([]byte, string, error) {
+	if opts.Size < QRCodeMinSize || opts.Size > QRCodeMaxSize {
+		opts.Size = QRCodeDefaultSize
+	}
+
+	renderOpts := qrcode.RenderOptions{Size: opts.Size}
+	if opts.WithLogo {
+		renderOpts.Logo = s.loadLogo()
+		// Logonun kapattığı modüllerin okunabilmesi için en az Q seviyesi kullanılır
+		opts.Level = qrcode.LevelWithLogo(opts.Level, renderOpts.Logo != nil)
+	}
+
+	code, err := qrcode.Encode(content, opts.Level)
+	if err != nil {
+		logconfig.Log.Error("QR kod kodlanamadı", zap.String("content", content), zap.Error(err))
+		return nil, "", ErrQRCodeGeneric
+	}
+
+	if opts.Format == QRCodeFormatSVG {
+		data, err := code.SVG(renderOpts)
+		if err != nil {
+			logconfig.Log.Error("QR kod SVG oluşturulamadı", zap.Error(err))
+			return nil, "", ErrQRCodeGeneric
+		}
+		return data, "image/svg+xml", nil
+	}
+
+	data, err := code.PNG(renderOpts)
+	if err != nil {
+		logconfig.Log.Error("QR kod PNG oluşturulamadı", zap.Error(err))
+		return nil, "", ErrQRCodeGeneric
+	}
+	return data, "image/png", nil
+}
+
+func (s *QRCodeService) loadLogo() image.Image {
+	s.logoOnce.Do(func() {
+		file, err := os.Open(qrCodeLogoPath)
+		if err != nil {
+			logconfig.Log.Warn("QR kod logosu açılamadı, logosuz devam ediliyor", zap.String("path", qrCodeLogoPath), zap.Error(err))
+			return
+		}
+		defer file.Close()
+
+		logo, _, err := image.Decode(file)
+		if err != nil {
+			logconfig.Log.Warn("QR kod logosu çözümlenemedi, logosuz devam ediliyor", zap.String("path", qrCodeLogoPath), zap.Error(err))
+			return
+		}
+		s.logo = logo
+	})
+	return s.logo
+}
+
+var _ IQRCodeService = (*QRCodeService)(nil)
@@ -0,0 +1,348 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrRSVPQuestionNotFound        ServiceError = "soru bulunamadı"
+	ErrRSVPQuestionLimit           ServiceError = "katılım formuna en fazla 10 soru eklenebilir"
+	ErrRSVPQuestionType            ServiceError = "geçersiz soru türü"
+	ErrRSVPQuestionOptions         ServiceError = "seçenekli sorularda 2 ile 20 arasında seçenek olmalıdır"
+	ErrRSVPQuestionOptionLength    ServiceError = "seçenekler en fazla 100 karakter olabilir"
+	ErrRSVPQuestionInvalidPosition ServiceError = "soru bu yöne taşınamaz"
+	ErrRSVPQuestionGeneric         ServiceError = "katılım soruları güncellenirken bir hata oluştu"
+
+	ErrRSVPAnswerRequired ServiceError = "bu soru zorunludur"
+	ErrRSVPAnswerInvalid  ServiceError = "geçersiz soru yanıtı"
+	ErrRSVPAnswerTooLong  ServiceError = "yanıt en fazla 500 karakter olabilir"
+	ErrRSVPAnswerNumber   ServiceError = "yanıt 0 ile 999999 arasında bir tam sayı olmalıdır"
+)
+
+const (
+	QuestionMoveUp   = "up"
+	QuestionMoveDown = "down"
+
+	maxRSVPQuestions      = 10
+	minRSVPChoices        = 2
+	maxRSVPChoices        = 20
+	maxRSVPChoiceLength   = 100
+	maxRSVPAnswerLength   = 500
+	maxRSVPAnswerNumber   = 999999
+	rsvpAnswerFieldPrefix = "question_"
+)
+
+var rsvpQuestionTypes = []models.RSVPQuestionType{
+	models.RSVPQuestionText,
+	models.RSVPQuestionSingleChoice,
+	models.RSVPQuestionMultiChoice,
+	models.RSVPQuestionNumber,
+}
+
+// RSVPAnswerError, katılım sorularından birine verilen yanıt kabul edilmediğinde hangi sorunun sorunlu olduğunu bildirir.
+type RSVPAnswerError struct {
+	Question string
+	Err      error
+}
+
+func (e *RSVPAnswerError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RSVPAnswerError) Unwrap() error {
+	return e.Err
+}
+
+// RSVPChoiceCount, seçenekli bir soruda bir seçeneği işaretleyen katılımcı sayısıdır.
+type RSVPChoiceCount struct {
+	Choice string
+	Count  int
+}
+
+// RSVPQuestionSummary, bir sorunun katılmayanlar dışındaki bildirimlerdeki yanıt dağılımıdır.
+type RSVPQuestionSummary struct {
+	Question models.RSVPQuestion
+	Answered int
+	Choices  []RSVPChoiceCount
+}
+
+type IRSVPQuestionService interface {
+	GetQuestions(invitationID uint) ([]models.RSVPQuestion, error)
+	CreateQuestion(ctx context.Context, question *models.RSVPQuestion) error
+	UpdateQuestion(ctx context.Context, invitationID, questionID uint, question *models.RSVPQuestion) error
+	// MoveQuestion, soruyu formda bir önceki veya sonraki soruyla yer değiştirir.
+	MoveQuestion(ctx context.Context, invitationID, questionID uint, direction string) error
+	DeleteQuestion(ctx context.Context, invitationID, questionID uint) error
+}
+
+type RSVPQuestionService struct {
+	repo repositories.IRSVPQuestionRepository
+}
+
+func NewRSVPQuestionService() IRSVPQuestionService {
+	return &RSVPQuestionService{repo: repositories.NewRSVPQuestionRepository()}
+}
+
+func (s *RSVPQuestionService) GetQuestions(invitationID uint) ([]models.RSVPQuestion, error) {
+	questions, err := s.repo.GetQuestionsByInvitationID(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Katılım soruları alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, ErrRSVPQuestionGeneric
+	}
+	return questions, nil
+}
+
+func (s *RSVPQuestionService) CreateQuestion(ctx context.Context, question *models.RSVPQuestion) error {
+	if err := normalizeRSVPQuestion(question); err != nil {
+		return err
+	}
+	count, err := s.repo.CountQuestions(question.InvitationID)
+	if err != nil {
+		logconfig.Log.Error("Katılım soruları sayılamadı", zap.Uint("invitation_id", question.InvitationID), zap.Error(err))
+		return ErrRSVPQuestionGeneric
+	}
+	if count >= maxRSVPQuestions {
+		return ErrRSVPQuestionLimit
+	}
+	sortOrder, err := s.repo.NextSortOrder(question.InvitationID)
+	if err != nil {
+		logconfig.Log.Error("Soru sırası alınamadı", zap.Uint("invitation_id", question.InvitationID), zap.Error(err))
+		return ErrRSVPQuestionGeneric
+	}
+	question.SortOrder = sortOrder
+	if err := s.repo.CreateQuestion(ctx, question); err != nil {
+		logconfig.Log.Error("Katılım sorusu eklenemedi", zap.Uint("invitation_id", question.InvitationID), zap.Error(err))
+		return ErrRSVPQuestionGeneric
+	}
+	return nil
+}
+
+func (s *RSVPQuestionService) UpdateQuestion(ctx context.Context, invitationID, questionID uint, question *models.RSVPQuestion) error {
+	if err := s.ensureQuestion(invitationID, questionID); err != nil {
+		return err
+	}
+	if err := normalizeRSVPQuestion(question); err != nil {
+		return err
+	}
+	data := map[string]interface{}{
+		"label":    question.Label,
+		"type":     question.Type,
+		"options":  question.Options,
+		"required": question.Required,
+	}
+	if err := s.repo.UpdateQuestion(ctx, questionID, data); err != nil {
+		logconfig.Log.Error("Katılım sorusu güncellenemedi", zap.Uint("question_id", questionID), zap.Error(err))
+		return ErrRSVPQuestionGeneric
+	}
+	return nil
+}
+
+func (s *RSVPQuestionService) MoveQuestion(ctx context.Context, invitationID, questionID uint, direction string) error {
+	questions, err := s.GetQuestions(invitationID)
+	if err != nil {
+		return err
+	}
+	index := slices.IndexFunc(questions, func(q models.RSVPQuestion) bool { return q.ID == questionID })
+	if index < 0 {
+		return ErrRSVPQuestionNotFound
+	}
+
+	target := index - 1
+	if direction == QuestionMoveDown {
+		target = index + 1
+	} else if direction != QuestionMoveUp {
+		return ErrRSVPQuestionInvalidPosition
+	}
+	if target < 0 || target >= len(questions) {
+		return ErrRSVPQuestionInvalidPosition
+	}
+
+	first, second := questions[index], questions[target]
+	if first.SortOrder == second.SortOrder {
+		first.SortOrder, second.SortOrder = index, target
+	}
+	if err := s.repo.SwapSortOrder(ctx, &first, &second); err != nil {
+		logconfig.Log.Error("Soru sırası güncellenemedi", zap.Uint("question_id", questionID), zap.Error(err))
+		return ErrRSVPQuestionGeneric
+	}
+	return nil
+}
+
+func (s *RSVPQuestionService) DeleteQuestion(ctx context.Context, invitationID, questionID uint) error {
+	if err := s.ensureQuestion(invitationID, questionID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteQuestion(ctx, questionID); err != nil {
+		logconfig.Log.Error("Katılım sorusu silinemedi", zap.Uint("question_id", questionID), zap.Error(err))
+		return ErrRSVPQuestionGeneric
+	}
+	return nil
+}
+
+// ensureQuestion, sorunun verilen davetiyeye ait olduğunu doğrular.
+func (s *RSVPQuestionService) ensureQuestion(invitationID, questionID uint) error {
+	if _, err := s.repo.GetQuestion(invitationID, questionID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrRSVPQuestionNotFound
+		}
+		logconfig.Log.Error("Katılım sorusu alınamadı", zap.Uint("question_id", questionID), zap.Error(err))
+		return ErrRSVPQuestionGeneric
+	}
+	return nil
+}
+
+// normalizeRSVPQuestion, seçenekleri satır satır ayırıp boş ve tekrar edenleri ayıklar; seçeneksiz türlerde seçenekleri siler.
+func normalizeRSVPQuestion(question *models.RSVPQuestion) error {
+	if !slices.Contains(rsvpQuestionTypes, question.Type) {
+		return ErrRSVPQuestionType
+	}
+	question.Label = strings.Join(strings.Fields(question.Label), " ")
+	if !question.IsChoice() {
+		question.Options = ""
+		return nil
+	}
+	var choices []string
+	for _, line := range strings.Split(question.Options, "\n") {
+		choice := strings.Join(strings.Fields(line), " ")
+		if choice == "" || slices.Contains(choices, choice) {
+			continue
+		}
+		if utf8.RuneCountInString(choice) > maxRSVPChoiceLength {
+			return ErrRSVPQuestionOptionLength
+		}
+		choices = append(choices, choice)
+	}
+	if len(choices) < minRSVPChoices || len(choices) > maxRSVPChoices {
+		return ErrRSVPQuestionOptions
+	}
+	question.Options = strings.Join(choices, "\n")
+	return nil
+}
+
+// BuildRSVPAnswers, formdan gelen değerleri (soru numarasına göre) sorulara göre doğrular ve kaydedilecek yanıtları döndürür.
+// Katılmayanlara soru sorulmadığından bu bildirimlerde yanıt tutulmaz ve zorunlu sorular aranmaz.
+func BuildRSVPAnswers(questions []models.RSVPQuestion, values map[uint][]string, status models.RSVPStatus) ([]models.RSVPAnswer, error) {
+	if status == models.RSVPDeclined {
+		return nil, nil
+	}
+	var answers []models.RSVPAnswer
+	for _, question := range questions {
+		var selected []string
+		for _, value := range values[question.ID] {
+			if value = strings.TrimSpace(value); value != "" {
+				selected = append(selected, value)
+			}
+		}
+		if len(selected) == 0 {
+			if question.Required {
+				return nil, &RSVPAnswerError{Question: question.Label, Err: ErrRSVPAnswerRequired}
+			}
+			continue
+		}
+		value, err := rsvpAnswerValue(question, selected)
+		if err != nil {
+			return nil, &RSVPAnswerError{Question: question.Label, Err: err}
+		}
+		answers = append(answers, models.RSVPAnswer{QuestionID: question.ID, Value: value})
+	}
+	return answers, nil
+}
+
+func rsvpAnswerValue(question models.RSVPQuestion, selected []string) (string, error) {
+	switch question.Type {
+	case models.RSVPQuestionSingleChoice:
+		if len(selected) != 1 || !slices.Contains(question.Choices(), selected[0]) {
+			return "", ErrRSVPAnswerInvalid
+		}
+		return selected[0], nil
+	case models.RSVPQuestionMultiChoice:
+		// Seçimler sorudaki sırayla ve tekrarsız saklanır
+		var chosen []string
+		for _, choice := range question.Choices() {
+			if slices.Contains(selected, choice) {
+				chosen = append(chosen, choice)
+			}
+		}
+		for _, value := range selected {
+			if !slices.Contains(chosen, value) {
+				return "", ErrRSVPAnswerInvalid
+			}
+		}
+		return strings.Join(chosen, "\n"), nil
+	case models.RSVPQuestionNumber:
+		if len(selected) != 1 {
+			return "", ErrRSVPAnswerInvalid
+		}
+		number, err := strconv.Atoi(selected[0])
+		if err != nil || number < 0 || number > maxRSVPAnswerNumber {
+			return "", ErrRSVPAnswerNumber
+		}
+		return strconv.Itoa(number), nil
+	default:
+		if len(selected) != 1 {
+			return "", ErrRSVPAnswerInvalid
+		}
+		text := strings.ReplaceAll(selected[0], "\r\n", "\n")
+		if utf8.RuneCountInString(text) > maxRSVPAnswerLength {
+			return "", ErrRSVPAnswerTooLong
+		}
+		return text, nil
+	}
+}
+
+// ParseRSVPAnswerField, katılım formundaki question_<numara> alanının soru numarasını döndürür.
+func ParseRSVPAnswerField(field string) (uint, bool) {
+	id, ok := strings.CutPrefix(field, rsvpAnswerFieldPrefix)
+	if !ok {
+		return 0, false
+	}
+	questionID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint(questionID), true
+}
+
+// SummarizeRSVPAnswers, katılmayanlar dışındaki bildirimlerde her soruya kaç kişinin yanıt verdiğini ve
+// seçenekli sorularda her seçeneğin kaç kez işaretlendiğini sayar (ör: menü tercihlerinin dağılımı).
+func SummarizeRSVPAnswers(questions []models.RSVPQuestion, participants []models.InvitationParticipant) []RSVPQuestionSummary {
+	summaries := make([]RSVPQuestionSummary, len(questions))
+	for i, question := range questions {
+		summary := RSVPQuestionSummary{Question: question}
+		for _, choice := range question.Choices() {
+			summary.Choices = append(summary.Choices, RSVPChoiceCount{Choice: choice})
+		}
+		for _, participant := range participants {
+			if participant.Status == models.RSVPDeclined {
+				continue
+			}
+			answer := participant.Answer(question.ID)
+			if answer == nil {
+				continue
+			}
+			summary.Answered++
+			for _, value := range answer.Values() {
+				for j := range summary.Choices {
+					if summary.Choices[j].Choice == value {
+						summary.Choices[j].Count++
+					}
+				}
+			}
+		}
+		summaries[i] = summary
+	}
+	return summaries
+}
+
+var _ IRSVPQuestionService = (*RSVPQuestionService)(nil)
@@ -0,0 +1,425 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"davet.link/configs/envconfig"
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/pdf"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrSeatingTableNotFound       ServiceError = "masa bulunamadı"
+	ErrSeatingTableLimit          ServiceError = "oturma planına en fazla 200 masa eklenebilir"
+	ErrSeatingTableCapacity       ServiceError = "masa kapasitesi 1 ile 100 kişi arasında olmalıdır"
+	ErrSeatingParticipantNotFound ServiceError = "katılımcı bulunamadı"
+	ErrSeatingNotAttending        ServiceError = "yalnızca katılacağını bildirenler masaya yerleştirilebilir"
+	ErrSeatingGeneric             ServiceError = "oturma planı güncellenirken bir hata oluştu"
+)
+
+const (
+	maxSeatingTables   = 200
+	maxSeatingCapacity = 100
+)
+
+// SeatedGuest, oturma planında bir masaya düşen kişidir. Adı bildirilmemiş refakatçiler Name boş olarak,
+// bağlı oldukları katılımcının (Host) adıyla tek satırda toplanır; Count bu satırdaki kişi sayısıdır.
+type SeatedGuest struct {
+	Name        string
+	Host        string // Refakatçinin bağlı olduğu katılımcı; katılımcının kendisi için boş
+	IsChild     bool
+	DietaryNote string
+	Count       int
+}
+
+// TableSeating, bir masanın oturanları ve doluluğudur.
+type TableSeating struct {
+	Table  models.SeatingTable
+	Guests []SeatedGuest
+	Seated int
+	Over   bool // Oturan kişi sayısı masa kapasitesini aşıyor
+}
+
+// SeatingPlan, katılacağını bildirenlerin masalara dağılımıdır.
+type SeatingPlan struct {
+	Tables          []TableSeating
+	Unassigned      []SeatedGuest
+	UnassignedCount int
+	Overfull        int // Kapasitesi aşılan masa sayısı
+	// Masaya yerleştirilebilecek katılımcılar (katılacağını bildirenler), alfabetik sırayla
+	Participants []models.InvitationParticipant
+}
+
+// GuestSeat, kişiye özel bağlantıda davetliye gösterilen masadır; Name boşsa davetlinin kendi masasıdır.
+type GuestSeat struct {
+	Name  string
+	Table string
+}
+
+type ISeatingService interface {
+	GetTables(invitationID uint) ([]models.SeatingTable, error)
+	// GetSeatingPlan, katılacağını bildirenleri masalarına göre dağıtır; masası olmayanlar ayrıca listelenir.
+	GetSeatingPlan(invitationID uint) (*SeatingPlan, error)
+	CreateTable(ctx context.Context, table *models.SeatingTable) error
+	UpdateTable(ctx context.Context, invitationID, tableID uint, table *models.SeatingTable) error
+	DeleteTable(ctx context.Context, invitationID, tableID uint) error
+	// AssignSeats, katılımcıyı (tableID 0 ise masasız) ve refakatçilerini masalara yerleştirir. companionTables,
+	// katılımcıdan ayrı oturacak refakatçilerin masalarıdır. Atama kapasiteyi aşsa da kaydedilir;
+	// dönen liste, atamadan sonra kapasitesi aşılan masalardır.
+	AssignSeats(ctx context.Context, invitationID, participantID, tableID uint, companionTables map[uint]uint) ([]TableSeating, error)
+	// GetGuestSeats, katılımcının ve ayrı masaya atanmış refakatçilerinin masalarını döndürür.
+	GetGuestSeats(participant *models.InvitationParticipant) ([]GuestSeat, error)
+	// WritePDF, oturma planını masa masa yazdırılabilir A4 liste olarak yazar.
+	WritePDF(w io.Writer, invitation *models.Invitation, plan *SeatingPlan, locale string) error
+}
+
+type SeatingService struct {
+	repo           repositories.ISeatingRepository
+	invitationRepo repositories.IInvitationRepository
+}
+
+func NewSeatingService() ISeatingService {
+	return &SeatingService{
+		repo:           repositories.NewSeatingRepository(),
+		invitationRepo: repositories.NewInvitationRepository(),
+	}
+}
+
+func (s *SeatingService) GetTables(invitationID uint) ([]models.SeatingTable, error) {
+	tables, err := s.repo.GetTablesByInvitationID(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Masalar alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, ErrSeatingGeneric
+	}
+	return tables, nil
+}
+
+func (s *SeatingService) GetSeatingPlan(invitationID uint) (*SeatingPlan, error) {
+	tables, participants, err := s.load(invitationID)
+	if err != nil {
+		return nil, err
+	}
+	return buildSeatingPlan(tables, participants), nil
+}
+
+func (s *SeatingService) CreateTable(ctx context.Context, table *models.SeatingTable) error {
+	if err := normalizeSeatingTable(table); err != nil {
+		return err
+	}
+	count, err := s.repo.CountTables(table.InvitationID)
+	if err != nil {
+		logconfig.Log.Error("Masalar sayılamadı", zap.Uint("invitation_id", table.InvitationID), zap.Error(err))
+		return ErrSeatingGeneric
+	}
+	if count >= maxSeatingTables {
+		return ErrSeatingTableLimit
+	}
+	sortOrder, err := s.repo.NextSortOrder(table.InvitationID)
+	if err != nil {
+		logconfig.Log.Error("Masa sırası alınamadı", zap.Uint("invitation_id", table.InvitationID), zap.Error(err))
+		return ErrSeatingGeneric
+	}
+	table.SortOrder = sortOrder
+	if err := s.repo.CreateTable(ctx, table); err != nil {
+		logconfig.Log.Error("Masa eklenemedi", zap.Uint("invitation_id", table.InvitationID), zap.Error(err))
+		return ErrSeatingGeneric
+	}
+	return nil
+}
+
+func (s *SeatingService) UpdateTable(ctx context.Context, invitationID, tableID uint, table *models.SeatingTable) error {
+	if err := s.ensureTable(invitationID, tableID); err != nil {
+		return err
+	}
+	if err := normalizeSeatingTable(table); err != nil {
+		return err
+	}
+	data := map[string]interface{}{
+		"name":     table.Name,
+		"capacity": table.Capacity,
+	}
+	if err := s.repo.UpdateTable(ctx, tableID, data); err != nil {
+		logconfig.Log.Error("Masa güncellenemedi", zap.Uint("table_id", tableID), zap.Error(err))
+		return ErrSeatingGeneric
+	}
+	return nil
+}
+
+func (s *SeatingService) DeleteTable(ctx context.Context, invitationID, tableID uint) error {
+	if err := s.ensureTable(invitationID, tableID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteTable(ctx, tableID); err != nil {
+		logconfig.Log.Error("Masa silinemedi", zap.Uint("table_id", tableID), zap.Error(err))
+		return ErrSeatingGeneric
+	}
+	return nil
+}
+
+func (s *SeatingService) AssignSeats(ctx context.Context, invitationID, participantID, tableID uint, companionTables map[uint]uint) ([]TableSeating, error) {
+	tables, participants, err := s.load(invitationID)
+	if err != nil {
+		return nil, err
+	}
+	valid := func(id uint) bool {
+		for _, table := range tables {
+			if table.ID == id {
+				return true
+			}
+		}
+		return false
+	}
+	index := -1
+	for i := range participants {
+		if participants[i].ID == participantID {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, ErrSeatingParticipantNotFound
+	}
+	participant := &participants[index]
+	if participant.Status != models.RSVPAttending {
+		return nil, ErrSeatingNotAttending
+	}
+	if tableID != 0 && !valid(tableID) {
+		return nil, ErrSeatingTableNotFound
+	}
+
+	participant.TableID = nil
+	if tableID != 0 {
+		participant.TableID = &tableID
+	}
+	// Katılımcıyla aynı masaya atanan refakatçi ayrı kaydedilmez; katılımcının masası değişince onunla taşınır
+	separate := make(map[uint]uint)
+	for i := range participant.Companions {
+		companion := &participant.Companions[i]
+		companion.TableID = nil
+		companionTableID, ok := companionTables[companion.ID]
+		if !ok || companionTableID == 0 || companionTableID == tableID {
+			continue
+		}
+		if !valid(companionTableID) {
+			return nil, ErrSeatingTableNotFound
+		}
+		separate[companion.ID] = companionTableID
+		companion.TableID = &companionTableID
+	}
+
+	if err := s.repo.AssignSeats(ctx, participantID, participant.TableID, separate); err != nil {
+		logconfig.Log.Error("Masa ataması kaydedilemedi", zap.Uint("participant_id", participantID), zap.Error(err))
+		return nil, ErrSeatingGeneric
+	}
+
+	// Yalnızca bu atamanın dokunduğu masalar uyarılır; önceden dolu olan masalar plan ekranında zaten işaretlidir
+	touched := map[uint]bool{tableID: true}
+	for _, companionTableID := range separate {
+		touched[companionTableID] = true
+	}
+	var over []TableSeating
+	for _, seating := range buildSeatingPlan(tables, participants).Tables {
+		if seating.Over && touched[seating.Table.ID] {
+			over = append(over, seating)
+		}
+	}
+	return over, nil
+}
+
+func (s *SeatingService) GetGuestSeats(participant *models.InvitationParticipant) ([]GuestSeat, error) {
+	if participant.Status != models.RSVPAttending {
+		return nil, nil
+	}
+	tables, err := s.repo.GetTablesByInvitationID(participant.InvitationID)
+	if err != nil {
+		logconfig.Log.Error("Masalar alınamadı", zap.Uint("invitation_id", participant.InvitationID), zap.Error(err))
+		return nil, ErrSeatingGeneric
+	}
+	names := make(map[uint]string, len(tables))
+	for _, table := range tables {
+		names[table.ID] = table.Name
+	}
+	var seats []GuestSeat
+	own := ""
+	if participant.TableID != nil {
+		own = names[*participant.TableID]
+		if own != "" {
+			seats = append(seats, GuestSeat{Table: own})
+		}
+	}
+	for _, companion := range participant.Companions {
+		if companion.TableID == nil {
+			continue
+		}
+		if table := names[*companion.TableID]; table != "" && table != own {
+			seats = append(seats, GuestSeat{Name: companion.Name, Table: table})
+		}
+	}
+	return seats, nil
+}
+
+func (s *SeatingService) WritePDF(w io.Writer, invitation *models.Invitation, plan *SeatingPlan, locale string) error {
+	title := invitation.Title
+	if title == "" {
+		title = invitation.InvitationKey
+	}
+	doc := pdf.New(title + " - " + i18n.T(locale, "Oturma Planı"))
+	doc.Footer = "davet.link/" + invitation.InvitationKey + " · " + time.Now().In(envconfig.GetLocation()).Format("02.01.2006 15:04")
+
+	seated := 0
+	for _, seating := range plan.Tables {
+		seated += seating.Seated
+	}
+	doc.Heading(title)
+	doc.Text(i18n.T(locale, "Oturma Planı") + " · " + i18n.T(locale, "%d masa, %d kişi", len(plan.Tables), seated))
+	doc.Space(6)
+
+	columns := []pdf.Column{
+		{Title: "#", Width: 1, Align: pdf.AlignRight},
+		{Title: i18n.T(locale, "Ad Soyad"), Width: 7},
+		{Title: i18n.T(locale, "Not"), Width: 6},
+	}
+	for _, seating := range plan.Tables {
+		heading := seating.Table.Name + " · " + i18n.T(locale, "%d / %d kişi", seating.Seated, seating.Table.Capacity)
+		if seating.Over {
+			heading += " · " + i18n.T(locale, "Kapasite aşıldı")
+		}
+		doc.Space(8)
+		doc.Paragraph(heading, pdf.Bold, 11)
+		if len(seating.Guests) == 0 {
+			doc.Text(i18n.T(locale, "Bu masaya henüz kimse yerleştirilmedi"))
+			continue
+		}
+		doc.Table(columns, seatedGuestRows(seating.Guests, locale), nil)
+	}
+	if len(plan.Unassigned) > 0 {
+		doc.Space(10)
+		doc.Paragraph(i18n.T(locale, "Masası belirlenmeyenler")+" · "+i18n.T(locale, "%d kişi", plan.UnassignedCount), pdf.Bold, 11)
+		doc.Table(columns, seatedGuestRows(plan.Unassigned, locale), nil)
+	}
+
+	_, err := doc.WriteTo(w)
+	return err
+}
+
+// load, davetiyenin masalarını ve katılımcılarını (refakatçileriyle) okur.
+func (s *SeatingService) load(invitationID uint) ([]models.SeatingTable, []models.InvitationParticipant, error) {
+	tables, err := s.GetTables(invitationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	participants, err := s.invitationRepo.GetParticipantsByInvitationID(invitationID)
+	if err != nil {
+		logconfig.Log.Error("Katılımcılar alınamadı", zap.Uint("invitation_id", invitationID), zap.Error(err))
+		return nil, nil, ErrSeatingGeneric
+	}
+	return tables, participants, nil
+}
+
+// ensureTable, masanın verilen davetiyeye ait olduğunu doğrular.
+func (s *SeatingService) ensureTable(invitationID, tableID uint) error {
+	if _, err := s.repo.GetTable(invitationID, tableID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrSeatingTableNotFound
+		}
+		logconfig.Log.Error("Masa alınamadı", zap.Uint("table_id", tableID), zap.Error(err))
+		return ErrSeatingGeneric
+	}
+	return nil
+}
+
+// buildSeatingPlan, katılacağını bildirenleri masalarına dağıtır. Refakatçi ayrı bir masaya atanmadıysa katılımcının
+// masasında, adı bildirilmemiş refakatçiler de katılımcının masasında sayılır. Silinmiş bir masaya işaret eden kişi masasız kabul edilir.
+func buildSeatingPlan(tables []models.SeatingTable, participants []models.InvitationParticipant) *SeatingPlan {
+	plan := &SeatingPlan{Tables: make([]TableSeating, len(tables))}
+	index := make(map[uint]int, len(tables))
+	for i, table := range tables {
+		plan.Tables[i] = TableSeating{Table: table}
+		index[table.ID] = i
+	}
+	seat := func(tableID *uint, guest SeatedGuest) {
+		if tableID != nil {
+			if i, ok := index[*tableID]; ok {
+				plan.Tables[i].Guests = append(plan.Tables[i].Guests, guest)
+				plan.Tables[i].Seated += guest.Count
+				return
+			}
+		}
+		plan.Unassigned = append(plan.Unassigned, guest)
+		plan.UnassignedCount += guest.Count
+	}
+
+	for _, participant := range sortParticipants(participants) {
+		if participant.Status != models.RSVPAttending {
+			continue
+		}
+		plan.Participants = append(plan.Participants, participant)
+		seat(participant.TableID, SeatedGuest{Name: participant.Title, Count: 1})
+		for _, companion := range participant.Companions {
+			tableID := participant.TableID
+			if companion.TableID != nil {
+				tableID = companion.TableID
+			}
+			seat(tableID, SeatedGuest{
+				Name:        companion.Name,
+				Host:        participant.Title,
+				IsChild:     companion.IsChild(),
+				DietaryNote: companion.DietaryNote,
+				Count:       1,
+			})
+		}
+		if unnamed := participant.GuestCount - 1 - len(participant.Companions); unnamed > 0 {
+			seat(participant.TableID, SeatedGuest{Host: participant.Title, Count: unnamed})
+		}
+	}
+
+	for i := range plan.Tables {
+		if plan.Tables[i].Seated > plan.Tables[i].Table.Capacity {
+			plan.Tables[i].Over = true
+			plan.Overfull++
+		}
+	}
+	return plan
+}
+
+// seatedGuestRows, masadaki kişileri PDF tablosu satırlarına çevirir; çocuklar ve beslenme notları Not sütununa yazılır.
+func seatedGuestRows(guests []SeatedGuest, locale string) [][]string {
+	rows := make([][]string, 0, len(guests))
+	for i, guest := range guests {
+		name := guest.Name
+		if name == "" {
+			name = i18n.T(locale, "%s misafiri (%d kişi)", guest.Host, guest.Count)
+		} else if guest.Host != "" {
+			name += " (" + guest.Host + ")"
+		}
+		var notes []string
+		if guest.IsChild {
+			notes = append(notes, i18n.T(locale, "Çocuk"))
+		}
+		if guest.DietaryNote != "" {
+			notes = append(notes, guest.DietaryNote)
+		}
+		rows = append(rows, []string{strconv.Itoa(i + 1), name, strings.Join(notes, ", ")})
+	}
+	return rows
+}
+
+// normalizeSeatingTable, masa adındaki fazla boşlukları temizler ve kapasiteyi denetler.
+func normalizeSeatingTable(table *models.SeatingTable) error {
+	table.Name = strings.Join(strings.Fields(table.Name), " ")
+	if table.Capacity < 1 || table.Capacity > maxSeatingCapacity {
+		return ErrSeatingTableCapacity
+	}
+	return nil
+}
+
+var _ ISeatingService = (*SeatingService)(nil)
@@ -0,0 +1,288 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"davet.link/configs/envconfig"
+	"davet.link/configs/logconfig"
+	"davet.link/pkg/sitemap"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrSitemapNotFound ServiceError = "site haritası bulunamadı"
+	ErrSitemapGeneric  ServiceError = "site haritası oluşturulurken bir hata oluştu"
+)
+
+const (
+	SitemapSectionStatic      = "static"
+	SitemapSectionCards       = "cards"
+	SitemapSectionInvitations = "invitations"
+
+	sitemapCacheTTL = 15 * time.Minute
+)
+
+// Arama motorlarına bildirilen statik sayfalar
+var staticSitemapPaths = []string{
+	"/",
+	"/kullanim-sartlari",
+	"/dijital_davetiye",
+	"/dijital_dugun_davetiyesi",
+	"/dijital_egitim_davetiyesi",
+}
+
+type ISitemapService interface {
+	// GetSitemap, adres sayısı tek dosyaya sığıyorsa <urlset>, sığmıyorsa <sitemapindex> döndürür.
+	GetSitemap() ([]byte, error)
+	GetSitemapPage(section string, page int) ([]byte, error)
+	GetRobots() []byte
+}
+
+type sitemapCacheEntry struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+type SitemapService struct {
+	invitationRepo repositories.IInvitationRepository
+	cardRepo       repositories.ICardRepository
+	pageRepo       repositories.IPageRepository
+
+	mu    sync.Mutex
+	cache map[string]sitemapCacheEntry
+}
+
+func NewSitemapService() ISitemapService {
+	return &SitemapService{
+		invitationRepo: repositories.NewInvitationRepository(),
+		cardRepo:       repositories.NewCardRepository(),
+		pageRepo:       repositories.NewPageRepository(),
+		cache:          make(map[string]sitemapCacheEntry),
+	}
+}
+
+func (s *SitemapService) GetSitemap() ([]byte, error) {
+	return s.cached("index", func() ([]byte, error) {
+		cardCount, invitationCount, err := s.counts()
+		if err != nil {
+			return nil, err
+		}
+		urls, err := s.staticURLs()
+		if err != nil {
+			return nil, err
+		}
+
+		pageSize := sitemapPageSize()
+		if len(urls)+int(cardCount)+int(invitationCount) <= pageSize {
+			cards, err := s.cardURLs(0, int(cardCount))
+			if err != nil {
+				return nil, err
+			}
+			invitations, err := s.invitationURLs(0, int(invitationCount))
+			if err != nil {
+				return nil, err
+			}
+			urls = append(append(urls, cards...), invitations...)
+			return sitemap.EncodeURLSet(urls)
+		}
+
+		baseURL := envconfig.GetBaseURL()
+		sitemaps := []sitemap.Sitemap{{Loc: sitemapPageURL(baseURL, SitemapSectionStatic, 1)}}
+		for page := 1; page <= pageCount(cardCount, pageSize); page++ {
+			sitemaps = append(sitemaps, sitemap.Sitemap{Loc: sitemapPageURL(baseURL, SitemapSectionCards, page)})
+		}
+		for page := 1; page <= pageCount(invitationCount, pageSize); page++ {
+			sitemaps = append(sitemaps, sitemap.Sitemap{Loc: sitemapPageURL(baseURL, SitemapSectionInvitations, page)})
+		}
+		return sitemap.EncodeIndex(sitemaps)
+	})
+}
+
+func (s *SitemapService) GetSitemapPage(section string, page int) ([]byte, error) {
+	if page < 1 {
+		return nil, ErrSitemapNotFound
+	}
+	return s.cached(fmt.Sprintf("%s-%d", section, page), func() ([]byte, error) {
+		pageSize := sitemapPageSize()
+		offset := (page - 1) * pageSize
+
+		var urls []sitemap.URL
+		var err error
+		switch section {
+		case SitemapSectionStatic:
+			if page != 1 {
+				return nil, ErrSitemapNotFound
+			}
+			urls, err = s.staticURLs()
+		case SitemapSectionCards:
+			urls, err = s.cardURLs(offset, pageSize)
+		case SitemapSectionInvitations:
+			urls, err = s.invitationURLs(offset, pageSize)
+		default:
+			return nil, ErrSitemapNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(urls) == 0 {
+			return nil, ErrSitemapNotFound
+		}
+		return sitemap.EncodeURLSet(urls)
+	})
+}
+
+// GetRobots, production dışındaki ortamlarda tüm siteyi taramaya kapatır.
+func (s *SitemapService) GetRobots() []byte {
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	if !envconfig.IsProduction() {
+		b.WriteString("Disallow: /\n")
+		return []byte(b.String())
+	}
+	for _, path := range []string{"/auth/", "/panel/", "/dashboard/", "/calendar/"} {
+		b.WriteString("Disallow: " + path + "\n")
+	}
+	b.WriteString("\nSitemap: " + envconfig.GetBaseURL() + "/sitemap.xml\n")
+	return []byte(b.String())
+}
+
+func (s *SitemapService) cached(key string, build func() ([]byte, error)) ([]byte, error) {
+	s.mu.Lock()
+	entry, ok := s.cache[key]
+	s.mu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.data, nil
+	}
+
+	data, err := build()
+	if err != nil {
+		if errors.Is(err, ErrSitemapNotFound) {
+			return nil, err
+		}
+		logconfig.Log.Error("Site haritası oluşturulamadı", zap.String("sitemap", key), zap.Error(err))
+		return nil, ErrSitemapGeneric
+	}
+
+	s.mu.Lock()
+	s.cache[key] = sitemapCacheEntry{data: data, expiresAt: time.Now().Add(sitemapCacheTTL)}
+	s.mu.Unlock()
+	return data, nil
+}
+
+func (s *SitemapService) counts() (int64, int64, error) {
+	cardCount, err := s.cardRepo.GetActiveCardCount()
+	if err != nil {
+		return 0, 0, err
+	}
+	invitationCount, err := s.invitationRepo.GetPublicInvitationCount()
+	if err != nil {
+		return 0, 0, err
+	}
+	return cardCount, invitationCount, nil
+}
+
+func (s *SitemapService) cardURLs(offset, limit int) ([]sitemap.URL, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	cards, err := s.cardRepo.GetActiveCardsForSitemap(offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	baseURL := envconfig.GetBaseURL()
+	urls := make([]sitemap.URL, 0, len(cards))
+	for _, card := range cards {
+		urls = append(urls, sitemap.URL{
+			Loc:        baseURL + "/@" + card.Slug,
+			LastMod:    card.UpdatedAt,
+			ChangeFreq: "monthly",
+			Priority:   0.6,
+		})
+	}
+	return urls, nil
+}
+
+func (s *SitemapService) invitationURLs(offset, limit int) ([]sitemap.URL, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	invitations, err := s.invitationRepo.GetPublicInvitationsForSitemap(offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	baseURL := envconfig.GetBaseURL()
+	urls := make([]sitemap.URL, 0, len(invitations))
+	for _, invitation := range invitations {
+		urls = append(urls, sitemap.URL{
+			Loc:        baseURL + "/" + invitation.InvitationKey,
+			LastMod:    invitation.UpdatedAt,
+			ChangeFreq: "weekly",
+			Priority:   0.5,
+		})
+	}
+	return urls, nil
+}
+
+// staticURLs, sabit sayfalara yayındaki yönetilen sayfaları ekler.
+func (s *SitemapService) staticURLs() ([]sitemap.URL, error) {
+	pages, err := s.pageRepo.GetPublishedPages()
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := envconfig.GetBaseURL()
+	urls := make([]sitemap.URL, 0, len(staticSitemapPaths)+len(pages))
+	lastMods := make(map[string]time.Time, len(pages))
+	for _, page := range pages {
+		lastMods["/"+page.Slug] = page.UpdatedAt
+	}
+	for _, path := range staticSitemapPaths {
+		priority := 0.8
+		if path == "/" {
+			priority = 1.0
+		}
+		urls = append(urls, sitemap.URL{
+			Loc:        baseURL + path,
+			LastMod:    lastMods[path],
+			ChangeFreq: "monthly",
+			Priority:   priority,
+		})
+		delete(lastMods, path)
+	}
+	for _, page := range pages {
+		if _, ok := lastMods["/"+page.Slug]; !ok {
+			continue
+		}
+		urls = append(urls, sitemap.URL{
+			Loc:        baseURL + "/" + page.Slug,
+			LastMod:    page.UpdatedAt,
+			ChangeFreq: "monthly",
+			Priority:   0.7,
+		})
+	}
+	return urls, nil
+}
+
+func sitemapPageSize() int {
+	size := envconfig.GetEnvAsInt("SITEMAP_PAGE_SIZE", sitemap.MaxURLsPerSitemap)
+	if size <= 0 || size > sitemap.MaxURLsPerSitemap {
+		return sitemap.MaxURLsPerSitemap
+	}
+	return size
+}
+
+func sitemapPageURL(baseURL, section string, page int) string {
+	return fmt.Sprintf("%s/sitemaps/%s-%d.xml", baseURL, section, page)
+}
+
+func pageCount(total int64, pageSize int) int {
+	return int((total + int64(pageSize) - 1) / int64(pageSize))
+}
+
+var _ ISitemapService = (*SitemapService)(nil)
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"davet.link/configs/logconfig"
+	"davet.link/models"
+	"davet.link/pkg/slugs"
+	"davet.link/repositories"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ErrSlugNotFound ServiceError = "adres bulunamadı"
+	ErrSlugInvalid  ServiceError = "adres yalnızca harf, rakam, - ve _ içerebilir"
+	ErrSlugReserved ServiceError = "bu adres sistem tarafından kullanılıyor"
+	ErrSlugTaken    ServiceError = "bu adres başka bir kayıt tarafından kullanılıyor"
+	ErrSlugGeneric  ServiceError = "adres kontrol edilirken bir hata oluştu"
+)
+
+type SlugKind string
+
+const (
+	SlugKindPage       SlugKind = "page"
+	SlugKindInvitation SlugKind = "invitation"
+	SlugKindCard       SlugKind = "card"
+
+	cardSlugPrefix = "@"
+)
+
+// Veritabanında karşılığı olmayan adreslerde kullanılan dosya tabanlı sayfalar
+var fileBackedPages = map[string]string{
+	"dijital_davetiye":          "website/dijital_davetiye",
+	"dijital_dugun_davetiyesi":  "website/dijital_dugun_davetiyesi",
+	"dijital_egitim_davetiyesi": "website/dijital_egitim_davetiyesi",
+}
+
+// ResolvedSlug, tek parçalı bir adresin neye karşılık geldiğini taşır; yalnızca türüne ait alan doludur.
+type ResolvedSlug struct {
+	Kind       SlugKind
+	Page       *models.Page
+	Template   string
+	Invitation *models.Invitation
+	Card       *models.Card
+}
+
+type ISlugService interface {
+	// Resolve, /<segment> adresini sayfa, davetiye veya (@ ile başlıyorsa) kartvizite çözer.
+	Resolve(segment string) (*ResolvedSlug, error)
+	// EnsureAvailable, adresin biçimini, ayrılmış adları ve diğer kayıtlarla çakışmayı kontrol eder.
+	EnsureAvailable(kind SlugKind, slug string, exceptID uint) error
+}
+
+type SlugService struct {
+	pageRepo       repositories.IPageRepository
+	invitationRepo repositories.IInvitationRepository
+	cardRepo       repositories.ICardRepository
+}
+
+func NewSlugService() ISlugService {
+	return &SlugService{
+		pageRepo:       repositories.NewPageRepository(),
+		invitationRepo: repositories.NewInvitationRepository(),
+		cardRepo:       repositories.NewCardRepository(),
+	}
+}
+
+func (s *SlugService) Resolve(segment string) (*ResolvedSlug, error) {
+	if cardSlug, ok := strings.CutPrefix(segment, cardSlugPrefix); ok {
+		card, err := s.cardRepo.GetActiveCardBySlug(cardSlug)
+		if err != nil {
+			return nil, s.resolveError(segment, err)
+		}
+		return &ResolvedSlug{Kind: SlugKindCard, Card: card}, nil
+	}
+	if segment == "" || slugs.IsReserved(segment) {
+		return nil, ErrSlugNotFound
+	}
+
+	page, err := s.pageRepo.GetPublishedPageBySlug(segment)
+	if err == nil {
+		return &ResolvedSlug{Kind: SlugKindPage, Page: page}, nil
+	}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, s.resolveError(segment, err)
+	}
+	if template, ok := fileBackedPages[segment]; ok {
+		return &ResolvedSlug{Kind: SlugKindPage, Template: template}, nil
+	}
+
+	invitation, err := s.invitationRepo.GetConfirmedInvitationByKey(segment)
+	if err != nil {
+		return nil, s.resolveError(segment, err)
+	}
+	return &ResolvedSlug{Kind: SlugKindInvitation, Invitation: invitation}, nil
+}
+
+func (s *SlugService) EnsureAvailable(kind SlugKind, slug string, exceptID uint) error {
+	if !slugs.Valid(slug) {
+		return ErrSlugInvalid
+	}
+	if slugs.IsReserved(slug) {
+		return ErrSlugReserved
+	}
+
+	// Kartvizitler /@ altında yayınlandığından yalnızca kendi aralarında çakışabilir;
+	// sayfalar ve davetiyeler aynı /<adres> alanını paylaşır.
+	checks := map[SlugKind]func(string, uint) (bool, error){}
+	switch kind {
+	case SlugKindCard:
+		checks[SlugKindCard] = s.cardRepo.CardSlugExists
+	case SlugKindInvitation:
+		if _, ok := fileBackedPages[strings.ToLower(slug)]; ok {
+			return ErrSlugReserved
+		}
+		checks[SlugKindInvitation] = s.invitationRepo.InvitationKeyExists
+		checks[SlugKindPage] = s.pageRepo.PageSlugExists
+	case SlugKindPage:
+		checks[SlugKindPage] = s.pageRepo.PageSlugExists
+		checks[SlugKindInvitation] = s.invitationRepo.InvitationKeyExists
+	}
+
+	for owner, exists := range checks {
+		// Başka türdeki kayıtlar için hariç tutulacak kayıt yoktur
+		id := uint(0)
+		if owner == kind {
+			id = exceptID
+		}
+		taken, err := exists(slug, id)
+		if err != nil {
+			logconfig.Log.Error("Adres kullanılabilirliği kontrol edilemedi",
+				zap.String("kind", string(kind)), zap.String("slug", slug), zap.Error(err))
+			return ErrSlugGeneric
+		}
+		if taken {
+			return ErrSlugTaken
+		}
+	}
+	return nil
+}
+
+func (s *SlugService) resolveError(segment string, err error) error {
+	if errors.Is(err, repositories.ErrNotFound) {
+		return ErrSlugNotFound
+	}
+	logconfig.Log.Error("Adres çözümlenemedi", zap.String("segment", segment), zap.Error(err))
+	return ErrSlugGeneric
+}
+
+var _ ISlugService = (*SlugService)(nil)
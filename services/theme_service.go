@@ -0,0 +1,117 @@
+package services
+
+import (
+	"time"
+
+	"davet.link/configs/envconfig"
+	"davet.link/models"
+	"davet.link/pkg/i18n"
+	"davet.link/pkg/themes"
+)
+
+const (
+	ErrThemeNotFound     ServiceError = "tema bulunamadı"
+	ErrThemeNotSupported ServiceError = "bu tema davetiyenin kategorisi için kullanılamaz"
+)
+
+// Önizlemedeki örnek davetiyenin adresi; gerçek davetiyelerde kullanılamaz
+const ThemePreviewKey = "tema-onizleme"
+
+type ThemePreview struct {
+	Theme      themes.Theme
+	View       string
+	Invitation *models.Invitation
+	Detail     *models.InvitationDetail
+}
+
+type IThemeService interface {
+	// GetThemesForInvitation, davetiyenin kategorisini destekleyen temaları döndürür.
+	GetThemesForInvitation(invitation *models.Invitation) []themes.Theme
+	// GetInvitationTheme, davetiyede kullanılacak temayı döndürür; geçersiz seçimlerde varsayılan tema gelir.
+	GetInvitationTheme(invitation *models.Invitation) themes.Theme
+	// GetPreview, temayı kaydetmeden görmek için kategori şablonunu örnek içerikle hazırlar.
+	GetPreview(name, category, locale string) (*ThemePreview, error)
+}
+
+type ThemeService struct{}
+
+func NewThemeService() IThemeService {
+	return &ThemeService{}
+}
+
+func (s *ThemeService) GetThemesForInvitation(invitation *models.Invitation) []themes.Theme {
+	return themes.ForCategory(InvitationCategoryTemplate(invitation))
+}
+
+func (s *ThemeService) GetInvitationTheme(invitation *models.Invitation) themes.Theme {
+	return themes.Resolve(invitation.Template, InvitationCategoryTemplate(invitation))
+}
+
+func (s *ThemeService) GetPreview(name, category, locale string) (*ThemePreview, error) {
+	theme, ok := themes.Get(name)
+	if !ok {
+		return nil, ErrThemeNotFound
+	}
+	if !themes.IsCategory(category) {
+		category = theme.Categories[0]
+	}
+	if !theme.Supports(category) {
+		return nil, ErrThemeNotSupported
+	}
+
+	loc := envconfig.GetLocation()
+	date := time.Now().In(loc).AddDate(0, 1, 0)
+	invitation := &models.Invitation{
+		InvitationKey: ThemePreviewKey,
+		Template:      theme.Name,
+		Title:         i18n.T(locale, "Örnek Davetiye"),
+		Description:   i18n.T(locale, "Bu sayfa, temanın örnek içerikle önizlemesidir."),
+		Venue:         i18n.T(locale, "Örnek Salon"),
+		Address:       "Cumhuriyet Cad. No:1, İstanbul",
+		Location:      "Taksim, İstanbul",
+		Telephone:     "+905550000000",
+		StartsAt:      time.Date(date.Year(), date.Month(), date.Day(), 19, 0, 0, 0, loc),
+		TimeZone:      envconfig.GetTimeZone(),
+		IsParticipant: true,
+		Category:      &models.InvitationCategory{Template: category},
+	}
+	detail := &models.InvitationDetail{IsMotherLive: true, IsFatherLive: true}
+	switch category {
+	case "wedding":
+		detail.Title = i18n.T(locale, "Düğün Töreni")
+		detail.BrideName, detail.BrideSurname = "Ayşe", "Yılmaz"
+		detail.GroomName, detail.GroomSurname = "Mehmet", "Kaya"
+		detail.IsBrideMotherLive, detail.BrideMotherName, detail.BrideMotherSurname = true, "Fatma", "Yılmaz"
+		detail.IsBrideFatherLive, detail.BrideFatherName, detail.BrideFatherSurname = true, "Ali", "Yılmaz"
+		detail.IsGroomMotherLive, detail.GroomMotherName, detail.GroomMotherSurname = true, "Zeynep", "Kaya"
+		detail.IsGroomFatherLive, detail.GroomFatherName, detail.GroomFatherSurname = true, "Hasan", "Kaya"
+	case "person-family":
+		detail.Title = i18n.T(locale, "Sünnet Töreni")
+		detail.Person = "Emir Demir"
+		detail.MotherName, detail.MotherSurname = "Elif", "Demir"
+		detail.FatherName, detail.FatherSurname = "Murat", "Demir"
+	case "person":
+		detail.Title = i18n.T(locale, "Mezuniyet Töreni")
+		detail.Person = "Deniz Aydın"
+	default:
+		detail.Title = i18n.T(locale, "Açılış Töreni")
+	}
+	invitation.InvitationDetail = detail
+
+	return &ThemePreview{
+		Theme:      theme,
+		View:       themes.CategoryView(category),
+		Invitation: invitation,
+		Detail:     detail,
+	}, nil
+}
+
+// InvitationCategoryTemplate, davetiyenin kategori şablonunu döndürür; kategori yüklenmemişse boş döner.
+func InvitationCategoryTemplate(invitation *models.Invitation) string {
+	if invitation.Category == nil {
+		return ""
+	}
+	return invitation.Category.Template
+}
+
+var _ IThemeService = (*ThemeService)(nil)